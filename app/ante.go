@@ -7,14 +7,20 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	channelkeeper "github.com/cosmos/ibc-go/modules/core/04-channel/keeper"
 	ibcante "github.com/cosmos/ibc-go/modules/core/ante"
+	claimkeeper "github.com/public-awesome/stargaze/x/claim/keeper"
+	claimtypes "github.com/public-awesome/stargaze/x/claim/types"
 )
 
+// MaxFreeInitialClaimGas is the highest gas limit of a fee-free initial claim
+const MaxFreeInitialClaimGas uint64 = 200000
+
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
 // channel keeper.
 type HandlerOptions struct {
 	ante.HandlerOptions
 
 	IBCChannelkeeper channelkeeper.Keeper
+	ClaimKeeper      claimkeeper.Keeper
 }
 
 type MinCommissionDecorator struct{}
@@ -49,6 +55,64 @@ func (MinCommissionDecorator) AnteHandle(
 	return next(ctx, tx, simulate)
 }
 
+type freeInitialClaimKey struct{}
+
+// FreeInitialClaimDecorator lets a transaction without fees whose only message is the
+// initial claim of an address with an unclaimed record skip the fee decorators.
+// Every address gets a single free claim, so it cannot flood the mempool with them.
+type FreeInitialClaimDecorator struct {
+	claimKeeper claimkeeper.Keeper
+}
+
+func NewFreeInitialClaimDecorator(claimKeeper claimkeeper.Keeper) FreeInitialClaimDecorator {
+	return FreeInitialClaimDecorator{claimKeeper: claimKeeper}
+}
+
+func (d FreeInitialClaimDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return next(ctx, tx, simulate)
+	}
+	msg, ok := msgs[0].(*claimtypes.MsgInitialClaim)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !feeTx.GetFee().IsZero() || feeTx.FeeGranter() != nil || feeTx.GetGas() > MaxFreeInitialClaimGas {
+		return next(ctx, tx, simulate)
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if !d.claimKeeper.IsFreeInitialClaimEligible(ctx, sender) {
+		return next(ctx, tx, simulate)
+	}
+	d.claimKeeper.SetFreeInitialClaimUsed(ctx, sender)
+	return next(ctx.WithValue(freeInitialClaimKey{}, true), tx, simulate)
+}
+
+// SkipFreeInitialClaimDecorator runs the wrapped fee decorator unless the transaction
+// was accepted as a free initial claim by the FreeInitialClaimDecorator
+type SkipFreeInitialClaimDecorator struct {
+	decorator sdk.AnteDecorator
+}
+
+func NewSkipFreeInitialClaimDecorator(decorator sdk.AnteDecorator) SkipFreeInitialClaimDecorator {
+	return SkipFreeInitialClaimDecorator{decorator: decorator}
+}
+
+func (d SkipFreeInitialClaimDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if free, ok := ctx.Value(freeInitialClaimKey{}).(bool); ok && free {
+		return next(ctx, tx, simulate)
+	}
+	return d.decorator.AnteHandle(ctx, tx, simulate, next)
+}
+
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
 // signer.
@@ -73,13 +137,14 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewMinCommissionDecorator(),
+		NewFreeInitialClaimDecorator(options.ClaimKeeper),
 		ante.NewRejectExtensionOptionsDecorator(),
-		ante.NewMempoolFeeDecorator(),
+		NewSkipFreeInitialClaimDecorator(ante.NewMempoolFeeDecorator()),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		NewSkipFreeInitialClaimDecorator(ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper)),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
@@ -0,0 +1,148 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/public-awesome/stargaze/app"
+	"github.com/public-awesome/stargaze/testutil/simapp"
+	claimtypes "github.com/public-awesome/stargaze/x/claim/types"
+)
+
+func TestFreeInitialClaim(t *testing.T) {
+	a := simapp.New(t.TempDir())
+	ctx := a.BaseApp.NewContext(false, tmproto.Header{Height: 2, ChainID: "stargaze-1", Time: time.Now().UTC()})
+	// mempool checks with minimum gas prices
+	ctx = ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(claimtypes.DefaultClaimDenom, sdk.NewDecWithPrec(1, 2))))
+
+	a.ClaimKeeper.CreateModuleAccount(ctx, sdk.NewCoin(claimtypes.DefaultClaimDenom, sdk.NewInt(10000000)))
+	a.ClaimKeeper.SetParams(ctx, claimtypes.Params{
+		AirdropEnabled:     true,
+		AirdropStartTime:   ctx.BlockTime(),
+		DurationUntilDecay: claimtypes.DefaultDurationUntilDecay,
+		DurationOfDecay:    claimtypes.DefaultDurationOfDecay,
+		ClaimDenom:         claimtypes.DefaultClaimDenom,
+	})
+
+	privs := make([]cryptotypes.PrivKey, 4)
+	addrs := make([]sdk.AccAddress, 4)
+	records := []claimtypes.ClaimRecord{}
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKey()
+		addrs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+		a.AccountKeeper.SetAccount(ctx, a.AccountKeeper.NewAccountWithAddress(ctx, addrs[i]))
+		// the last address has no claim record
+		if i == len(privs)-1 {
+			continue
+		}
+		records = append(records, claimtypes.ClaimRecord{
+			Address:                addrs[i].String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(claimtypes.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		})
+	}
+	err := a.ClaimKeeper.SetClaimRecords(ctx, records)
+	require.NoError(t, err)
+
+	anteHandler, err := app.NewAnteHandler(app.HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   a.AccountKeeper,
+			BankKeeper:      a.BankKeeper,
+			SignModeHandler: a.GetTxConfig().SignModeHandler(),
+			FeegrantKeeper:  a.FeeGrantKeeper,
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+		IBCChannelkeeper: a.IBCKeeper.ChannelKeeper,
+		ClaimKeeper:      a.ClaimKeeper,
+	})
+	require.NoError(t, err)
+
+	initialClaim := func(i int) sdk.Msg {
+		return claimtypes.NewMsgInitialClaim(addrs[i].String())
+	}
+	tests := []struct {
+		name string
+		from int
+		msgs []sdk.Msg
+		gas  uint64
+		err  error
+	}{
+		{
+			name: "free initial claim",
+			from: 0,
+			msgs: []sdk.Msg{initialClaim(0)},
+			gas:  app.MaxFreeInitialClaimGas,
+		},
+		{
+			name: "second free initial claim",
+			from: 0,
+			msgs: []sdk.Msg{initialClaim(0)},
+			gas:  app.MaxFreeInitialClaimGas,
+			err:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "gas above the cap",
+			from: 1,
+			msgs: []sdk.Msg{initialClaim(1)},
+			gas:  app.MaxFreeInitialClaimGas + 1,
+			err:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "other messages",
+			from: 1,
+			msgs: []sdk.Msg{initialClaim(1), banktypes.NewMsgSend(addrs[1], addrs[3], sdk.NewCoins())},
+			gas:  app.MaxFreeInitialClaimGas,
+			err:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "no claim record",
+			from: 3,
+			msgs: []sdk.Msg{initialClaim(3)},
+			gas:  app.MaxFreeInitialClaimGas,
+			err:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "free initial claim after rejected transactions",
+			from: 1,
+			msgs: []sdk.Msg{initialClaim(1)},
+			gas:  app.MaxFreeInitialClaimGas,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acc := a.AccountKeeper.GetAccount(ctx, addrs[tt.from])
+			tx, err := helpers.GenTx(a.GetTxConfig(), tt.msgs, sdk.NewCoins(), tt.gas, ctx.ChainID(),
+				[]uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, privs[tt.from])
+			require.NoError(t, err)
+
+			cacheCtx, write := ctx.CacheContext()
+			_, err = anteHandler(cacheCtx, tx, false)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			write()
+		})
+	}
+
+	// a completed initial claim is never free
+	_, err = a.ClaimKeeper.ClaimCoinsForAction(ctx, addrs[2], claimtypes.ActionInitialClaim)
+	require.NoError(t, err)
+	acc := a.AccountKeeper.GetAccount(ctx, addrs[2])
+	tx, err := helpers.GenTx(a.GetTxConfig(), []sdk.Msg{initialClaim(2)}, sdk.NewCoins(), app.MaxFreeInitialClaimGas, ctx.ChainID(),
+		[]uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, privs[2])
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
@@ -1,9 +1,11 @@
 package cmd
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"sort"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -15,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -50,11 +53,16 @@ type SnapshotAccount struct {
 	AtomAddress              string  `json:"atom_address"`
 	OsmoAddress              string  `json:"osmo_address"`
 	RegenAddress             string  `json:"regen_address"`
+	AtomStakedBalance        sdk.Int `json:"atom_staked_balance"`
+	OsmoStakedBalance        sdk.Int `json:"osmo_staked_balance"`
+	RegenStakedBalance       sdk.Int `json:"regen_staked_balance"`
+	OsmoPoolBalance          sdk.Int `json:"osmo_pool_balance"`
 	StargazeHubDelegator     bool    `json:"sg_hub_delegator"`
 	StargazeOsmosisDelegator bool    `json:"sg_osmosis_delegator"`
 	StargazeRegenDelegator   bool    `json:"sg_regen_delegator"`
 	AtomStaker               bool    `json:"atom_staker"`
 	OsmoStaker               bool    `json:"osmo_staker"`
+	RegenStaker              bool    `json:"regen_staker"`
 	OsmosisLiquidityProvider bool    `json:"osmosis_lp"`
 	AirdropAmount            sdk.Int `json:"airdrop_amount"`
 }
@@ -123,11 +131,14 @@ Example:
 			chainID := args[1]
 
 			// read snapshot.json and parse into struct
-			snapshotFile, _ := ioutil.ReadFile(args[2])
+			snapshotFile, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return fmt.Errorf("failed to read snapshot: %w", err)
+			}
 			snapshot := Snapshot{}
 			err = json.Unmarshal(snapshotFile, &snapshot)
 			if err != nil {
-				panic(err)
+				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
 			}
 
 			// run Prepare Genesis
@@ -253,47 +264,99 @@ func PrepareGenesis(
 	// bank module genesis
 	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
 	bankGenState.Params.DefaultSendEnabled = true
+	bankGenState.DenomMetadata = genesisParams.NativeCoinMetadatas
 	balances := bankGenState.Balances
+	// coins added to balances on top of the input genesis
+	addedCoins := sdk.NewCoins()
 
-	// claim module genesis
-	claimGenState := claimtypes.GetGenesisStateFromAppState(cdc, appState)
-	claimGenState.Params = genesisParams.ClaimParams
-	claimRecords := make([]claimtypes.ClaimRecord, 0, len(snapshot.Accounts))
-	claimsTotal := sdk.ZeroInt()
 	// check from preexisint accounts in genesis
 	preExistingAccounts := make(map[string]bool)
 	for _, b := range balances {
 		preExistingAccounts[b.Address] = true
 	}
-	for addr, acc := range snapshot.Accounts {
-		claimRecord := claimtypes.ClaimRecord{
-			Address:                addr,
-			InitialClaimableAmount: sdk.NewCoins(sdk.NewCoin(BaseCoinUnit, acc.AirdropAmount)),
-			ActionCompleted:        []bool{false, false, false, false, false},
-		}
-		claimsTotal = claimsTotal.Add(acc.AirdropAmount)
-		claimRecords = append(claimRecords, claimRecord)
-		// skip account addition if existent
-		exists := preExistingAccounts[addr]
-		if exists {
-			continue
+	for _, acc := range accs {
+		preExistingAccounts[acc.GetAddress().String()] = true
+	}
+	addAccount := func(address sdk.AccAddress, coins sdk.Coins) error {
+		addr := address.String()
+		if preExistingAccounts[addr] {
+			return nil
 		}
+		preExistingAccounts[addr] = true
 		balances = append(balances, banktypes.Balance{
 			Address: addr,
-			Coins:   sdk.NewCoins(sdk.NewInt64Coin(BaseCoinUnit, 1)),
+			Coins:   coins,
 		})
+		addedCoins = addedCoins.Add(coins...)
 
-		address, err := sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return nil, nil, err
-		}
-		// add base account
 		// Add the new account to the set of genesis accounts
 		baseAccount := authtypes.NewBaseAccount(address, nil, 0, 0)
 		if err := baseAccount.Validate(); err != nil {
-			return nil, nil, fmt.Errorf("failed to validate new genesis account: %w", err)
+			return fmt.Errorf("failed to validate new genesis account: %w", err)
 		}
 		accs = append(accs, baseAccount)
+		return nil
+	}
+
+	// strategic reserve accounts
+	for _, reserve := range genesisParams.StrategicReserveAccounts {
+		address, err := sdk.AccAddressFromBech32(reserve.Address)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid strategic reserve address %s: %w", reserve.Address, err)
+		}
+		if preExistingAccounts[address.String()] {
+			return nil, nil, fmt.Errorf("strategic reserve account %s already exists in genesis", reserve.Address)
+		}
+		if err := addAccount(address, reserve.Coins); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// claim module genesis
+	claimGenState := claimtypes.GetGenesisStateFromAppState(cdc, appState)
+	claimGenState.Params = genesisParams.ClaimParams
+
+	// snapshot accounts may use any bech32 prefix, merge them by their stars address
+	airdropAmounts := make(map[string]sdk.Int)
+	airdropAddresses := make([]sdk.AccAddress, 0, len(snapshot.Accounts))
+	for addr, acc := range snapshot.Accounts {
+		if !acc.AirdropAmount.IsPositive() {
+			continue
+		}
+		_, bz, err := bech32.DecodeAndConvert(addr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid snapshot address %s: %w", addr, err)
+		}
+		address := sdk.AccAddress(bz)
+		amount, ok := airdropAmounts[address.String()]
+		if !ok {
+			amount = sdk.ZeroInt()
+			airdropAddresses = append(airdropAddresses, address)
+		}
+		airdropAmounts[address.String()] = amount.Add(acc.AirdropAmount)
+	}
+	sort.Slice(airdropAddresses, func(i, j int) bool {
+		return bytes.Compare(airdropAddresses[i], airdropAddresses[j]) < 0
+	})
+
+	claimRecords := make([]claimtypes.ClaimRecord, 0, len(airdropAddresses))
+	claimsTotal := sdk.ZeroInt()
+	for _, address := range airdropAddresses {
+		amount := airdropAmounts[address.String()]
+		claimRecords = append(claimRecords, claimtypes.ClaimRecord{
+			Address:                address.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewCoin(BaseCoinUnit, amount)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		})
+		claimsTotal = claimsTotal.Add(amount)
+
+		// give new accounts 1ustars so they exist on chain
+		if err := addAccount(address, sdk.NewCoins(sdk.NewInt64Coin(BaseCoinUnit, 1))); err != nil {
+			return nil, nil, err
+		}
+	}
+	if claimsTotal.GT(genesisParams.AirdropSupply) {
+		return nil, nil, fmt.Errorf("total airdrop amount %s exceeds airdrop supply %s", claimsTotal, genesisParams.AirdropSupply)
 	}
 	claimGenState.ClaimRecords = claimRecords
 	claimGenState.ModuleAccountBalance = sdk.NewCoin(BaseCoinUnit, claimsTotal)
@@ -320,6 +383,9 @@ func PrepareGenesis(
 
 	// save balances
 	bankGenState.Balances = banktypes.SanitizeGenesisBalances(balances)
+	if !bankGenState.Supply.Empty() {
+		bankGenState.Supply = bankGenState.Supply.Add(addedCoins...)
+	}
 	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal bank genesis state: %w", err)
@@ -362,6 +428,8 @@ func MainnetGenesisParams() GenesisParams {
 			},
 			Base:    BaseCoinUnit,
 			Display: HumanCoinUnit,
+			Name:    "Stargaze STARS",
+			Symbol:  "STARS",
 		},
 	}
 
@@ -438,6 +506,7 @@ func MainnetGenesisParams() GenesisParams {
 		DurationUntilDecay: time.Hour * 24 * 120,                            // 120 days = ~4 months
 		DurationOfDecay:    time.Hour * 24 * 120,                            // 120 days = ~4 months
 		ClaimDenom:         genParams.NativeCoinMetadatas[0].Base,
+		ActionWeights:      claimtypes.DefaultActionWeights(),
 	}
 
 	genParams.ConsensusParams = tmtypes.DefaultConsensusParams()
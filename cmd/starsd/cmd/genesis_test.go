@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/public-awesome/stargaze/app"
+	claimtypes "github.com/public-awesome/stargaze/x/claim/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spm/cosmoscmd"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestPrepareGenesis(t *testing.T) {
+	cosmoscmd.SetPrefixes(app.AccountAddressPrefix)
+	encodingConfig := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Marshaler).
+		WithTxConfig(encodingConfig.TxConfig)
+
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	reserve := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	atomAddr1, err := bech32.ConvertAndEncode("cosmos", addr1)
+	require.NoError(t, err)
+	osmoAddr1, err := bech32.ConvertAndEncode("osmo", addr1)
+	require.NoError(t, err)
+	osmoAddr2, err := bech32.ConvertAndEncode("osmo", addr2)
+	require.NoError(t, err)
+
+	snapshot := Snapshot{
+		TotalStarsAirdropAmount: sdk.NewInt(600),
+		Accounts: map[string]SnapshotAccount{
+			atomAddr1: {AtomAddress: atomAddr1, AtomStaker: true, AirdropAmount: sdk.NewInt(100)},
+			osmoAddr1: {OsmoAddress: osmoAddr1, OsmoStaker: true, AirdropAmount: sdk.NewInt(200)},
+			osmoAddr2: {OsmoAddress: osmoAddr2, OsmosisLiquidityProvider: true, AirdropAmount: sdk.NewInt(300)},
+		},
+	}
+
+	genesisParams := TestnetGenesisParams()
+	genesisParams.StrategicReserveAccounts = []banktypes.Balance{
+		{Address: reserve.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(BaseCoinUnit, 1000))},
+	}
+
+	appState := app.ModuleBasics.DefaultGenesis(encodingConfig.Marshaler)
+	appState, genDoc, err := PrepareGenesis(clientCtx, appState, &tmtypes.GenesisDoc{}, genesisParams, "stargaze-1", snapshot)
+	require.NoError(t, err)
+	require.Equal(t, "stargaze-1", genDoc.ChainID)
+	require.NoError(t, app.ModuleBasics.ValidateGenesis(encodingConfig.Marshaler, encodingConfig.TxConfig, appState))
+
+	claimGenState := claimtypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
+	require.Equal(t, sdk.NewInt64Coin(BaseCoinUnit, 600), claimGenState.ModuleAccountBalance)
+	require.Len(t, claimGenState.ClaimRecords, 2)
+	amounts := make(map[string]sdk.Coins)
+	for _, record := range claimGenState.ClaimRecords {
+		amounts[record.Address] = record.InitialClaimableAmount
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(BaseCoinUnit, 300)), amounts[addr1.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(BaseCoinUnit, 300)), amounts[addr2.String()])
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
+	require.Len(t, bankGenState.DenomMetadata, 1)
+	require.Equal(t, BaseCoinUnit, bankGenState.DenomMetadata[0].Base)
+	balances := make(map[string]sdk.Coins)
+	for _, balance := range bankGenState.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(BaseCoinUnit, 1000)), balances[reserve.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(BaseCoinUnit, 1)), balances[addr1.String()])
+
+	// output is deterministic
+	appState2, _, err := PrepareGenesis(clientCtx, app.ModuleBasics.DefaultGenesis(encodingConfig.Marshaler), &tmtypes.GenesisDoc{}, genesisParams, "stargaze-1", snapshot)
+	require.NoError(t, err)
+	require.Equal(t, appState[claimtypes.ModuleName], appState2[claimtypes.ModuleName])
+
+	// airdrop can't exceed the airdrop supply
+	genesisParams.AirdropSupply = sdk.NewInt(599)
+	_, _, err = PrepareGenesis(clientCtx, app.ModuleBasics.DefaultGenesis(encodingConfig.Marshaler), &tmtypes.GenesisDoc{}, genesisParams, "stargaze-1", snapshot)
+	require.Error(t, err)
+}
@@ -0,0 +1,420 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const (
+	flagHubValidator       = "hub-validator"
+	flagOsmosisValidator   = "osmosis-validator"
+	flagRegenValidator     = "regen-validator"
+	flagAirdropSupply      = "airdrop-supply"
+	flagMinStakedAmount    = "min-staked-amount"
+	flagWeightAtomStaker   = "weight-atom-staker"
+	flagWeightOsmoStaker   = "weight-osmo-staker"
+	flagWeightOsmosisLP    = "weight-osmosis-lp"
+	flagWeightRegenStaker  = "weight-regen-staker"
+	flagWeightSGDelegation = "weight-sg-delegator"
+
+	// osmosis liquidity pool share denoms are prefixed with this
+	osmosisPoolDenomPrefix = "gamm/pool/"
+	// type of module accounts in the exported auth genesis
+	moduleAccountType = "/cosmos.auth.v1beta1.ModuleAccount"
+)
+
+// SnapshotWeights defines how many points each eligible category adds to an account.
+// Accounts receive a share of the airdrop supply proportional to their points.
+type SnapshotWeights struct {
+	AtomStaker               sdk.Dec
+	OsmoStaker               sdk.Dec
+	OsmosisLiquidityProvider sdk.Dec
+	RegenStaker              sdk.Dec
+	// added once for every chain the account delegates to the Stargaze validator
+	StargazeDelegator sdk.Dec
+}
+
+type SnapshotConfig struct {
+	AirdropSupply sdk.Int
+	// minimum staked amount for an account to count as a staker
+	MinStakedAmount sdk.Int
+
+	// Stargaze validator operator addresses on each chain
+	HubValidator     string
+	OsmosisValidator string
+	RegenValidator   string
+
+	Weights SnapshotWeights
+}
+
+// snapshotGenesis contains the parts of an exported genesis the snapshot reads
+type snapshotGenesis struct {
+	AppState struct {
+		Auth struct {
+			Accounts []struct {
+				Type        string `json:"@type"`
+				BaseAccount struct {
+					Address string `json:"address"`
+				} `json:"base_account"`
+			} `json:"accounts"`
+		} `json:"auth"`
+		Bank struct {
+			Balances []struct {
+				Address string    `json:"address"`
+				Coins   sdk.Coins `json:"coins"`
+			} `json:"balances"`
+		} `json:"bank"`
+		Staking struct {
+			Validators []struct {
+				OperatorAddress string  `json:"operator_address"`
+				Tokens          sdk.Int `json:"tokens"`
+				DelegatorShares sdk.Dec `json:"delegator_shares"`
+			} `json:"validators"`
+			Delegations []struct {
+				DelegatorAddress string  `json:"delegator_address"`
+				ValidatorAddress string  `json:"validator_address"`
+				Shares           sdk.Dec `json:"shares"`
+			} `json:"delegations"`
+		} `json:"staking"`
+		Lockup struct {
+			Locks []struct {
+				Owner string    `json:"owner"`
+				Coins sdk.Coins `json:"coins"`
+			} `json:"locks"`
+		} `json:"lockup"`
+	} `json:"app_state"`
+}
+
+func ExportAirdropSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-airdrop-snapshot [hub-genesis] [osmosis-genesis] [regen-genesis] [output-file]",
+		Short: "Export the airdrop snapshot from exported genesis files of other chains",
+		Long: `Export the airdrop snapshot from exported genesis files of the Cosmos Hub, Osmosis and Regen.
+Stakers on every chain, Osmosis liquidity providers and delegators to the Stargaze validators
+are eligible. Each eligible category adds its weight to the account's points and the airdrop
+supply is split proportionally to the points. The output can be used with prepare-genesis.
+Example:
+	starsd export-airdrop-snapshot hub.json osmosis.json regen.json snapshot.json \
+		--hub-validator cosmosvaloper1... --osmosis-validator osmovaloper1... --regen-validator regenvaloper1...
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := snapshotConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			genesis := make([]snapshotGenesis, 3)
+			for i, file := range args[:3] {
+				genesis[i], err = readSnapshotGenesis(file)
+				if err != nil {
+					return err
+				}
+			}
+
+			snapshot, err := ExportAirdropSnapshot(genesis[0], genesis[1], genesis[2], config)
+			if err != nil {
+				return err
+			}
+
+			snapshotJSON, err := json.MarshalIndent(snapshot, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal snapshot: %w", err)
+			}
+			if err := ioutil.WriteFile(args[3], snapshotJSON, 0o600); err != nil {
+				return fmt.Errorf("failed to write snapshot: %w", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%d accounts, %s%s total\n",
+				len(snapshot.Accounts), snapshot.TotalStarsAirdropAmount, BaseCoinUnit)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagHubValidator, "", "Stargaze validator operator address on the Cosmos Hub")
+	cmd.Flags().String(flagOsmosisValidator, "", "Stargaze validator operator address on Osmosis")
+	cmd.Flags().String(flagRegenValidator, "", "Stargaze validator operator address on Regen")
+	cmd.Flags().String(flagAirdropSupply, MainnetGenesisParams().AirdropSupply.String(), "Total airdrop supply in ustars")
+	cmd.Flags().String(flagMinStakedAmount, "1", "Minimum staked amount to count as a staker")
+	cmd.Flags().String(flagWeightAtomStaker, "1", "Points for staking on the Cosmos Hub")
+	cmd.Flags().String(flagWeightOsmoStaker, "1", "Points for staking on Osmosis")
+	cmd.Flags().String(flagWeightOsmosisLP, "1", "Points for providing liquidity on Osmosis")
+	cmd.Flags().String(flagWeightRegenStaker, "1", "Points for staking on Regen")
+	cmd.Flags().String(flagWeightSGDelegation, "1", "Points for every chain delegating to the Stargaze validator")
+
+	return cmd
+}
+
+func snapshotConfigFromFlags(cmd *cobra.Command) (SnapshotConfig, error) {
+	config := SnapshotConfig{}
+	config.HubValidator, _ = cmd.Flags().GetString(flagHubValidator)
+	config.OsmosisValidator, _ = cmd.Flags().GetString(flagOsmosisValidator)
+	config.RegenValidator, _ = cmd.Flags().GetString(flagRegenValidator)
+
+	supply, _ := cmd.Flags().GetString(flagAirdropSupply)
+	var ok bool
+	config.AirdropSupply, ok = sdk.NewIntFromString(supply)
+	if !ok {
+		return config, fmt.Errorf("invalid airdrop supply: %s", supply)
+	}
+	minStaked, _ := cmd.Flags().GetString(flagMinStakedAmount)
+	config.MinStakedAmount, ok = sdk.NewIntFromString(minStaked)
+	if !ok {
+		return config, fmt.Errorf("invalid min staked amount: %s", minStaked)
+	}
+
+	weights := []struct {
+		flag  string
+		value *sdk.Dec
+	}{
+		{flagWeightAtomStaker, &config.Weights.AtomStaker},
+		{flagWeightOsmoStaker, &config.Weights.OsmoStaker},
+		{flagWeightOsmosisLP, &config.Weights.OsmosisLiquidityProvider},
+		{flagWeightRegenStaker, &config.Weights.RegenStaker},
+		{flagWeightSGDelegation, &config.Weights.StargazeDelegator},
+	}
+	for _, w := range weights {
+		s, _ := cmd.Flags().GetString(w.flag)
+		weight, err := sdk.NewDecFromStr(s)
+		if err != nil {
+			return config, fmt.Errorf("invalid %s: %w", w.flag, err)
+		}
+		if weight.IsNegative() {
+			return config, fmt.Errorf("%s must not be negative", w.flag)
+		}
+		*w.value = weight
+	}
+	return config, nil
+}
+
+func readSnapshotGenesis(file string) (snapshotGenesis, error) {
+	genesis := snapshotGenesis{}
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return genesis, fmt.Errorf("failed to read genesis %s: %w", file, err)
+	}
+	if err := json.Unmarshal(bz, &genesis); err != nil {
+		return genesis, fmt.Errorf("failed to unmarshal genesis %s: %w", file, err)
+	}
+	return genesis, nil
+}
+
+// ExportAirdropSnapshot builds the airdrop snapshot from the exported genesis of the Cosmos Hub, Osmosis and Regen
+func ExportAirdropSnapshot(hub, osmosis, regen snapshotGenesis, config SnapshotConfig) (Snapshot, error) {
+	snapshot := Snapshot{
+		TotalStarsAirdropAmount: sdk.ZeroInt(),
+		Accounts:                make(map[string]SnapshotAccount),
+	}
+
+	getAccount := func(addr string) (string, SnapshotAccount, error) {
+		starsAddr, err := convertToStarsAddress(addr)
+		if err != nil {
+			return "", SnapshotAccount{}, err
+		}
+		acc, ok := snapshot.Accounts[starsAddr]
+		if !ok {
+			acc = SnapshotAccount{
+				AtomStakedBalance:  sdk.ZeroInt(),
+				OsmoStakedBalance:  sdk.ZeroInt(),
+				RegenStakedBalance: sdk.ZeroInt(),
+				OsmoPoolBalance:    sdk.ZeroInt(),
+				AirdropAmount:      sdk.ZeroInt(),
+			}
+		}
+		return starsAddr, acc, nil
+	}
+
+	// hub stakers
+	hubStaked, hubSGDelegators, err := stakedBalances(hub, config.HubValidator)
+	if err != nil {
+		return snapshot, err
+	}
+	for addr, staked := range hubStaked {
+		starsAddr, acc, err := getAccount(addr)
+		if err != nil {
+			return snapshot, err
+		}
+		acc.AtomAddress = addr
+		acc.AtomStakedBalance = staked
+		acc.AtomStaker = staked.GTE(config.MinStakedAmount)
+		acc.StargazeHubDelegator = hubSGDelegators[addr]
+		snapshot.Accounts[starsAddr] = acc
+	}
+
+	// osmosis stakers
+	osmoStaked, osmoSGDelegators, err := stakedBalances(osmosis, config.OsmosisValidator)
+	if err != nil {
+		return snapshot, err
+	}
+	for addr, staked := range osmoStaked {
+		starsAddr, acc, err := getAccount(addr)
+		if err != nil {
+			return snapshot, err
+		}
+		acc.OsmoAddress = addr
+		acc.OsmoStakedBalance = staked
+		acc.OsmoStaker = staked.GTE(config.MinStakedAmount)
+		acc.StargazeOsmosisDelegator = osmoSGDelegators[addr]
+		snapshot.Accounts[starsAddr] = acc
+	}
+
+	// osmosis liquidity providers, either holding pool shares or locking them
+	for addr, shares := range osmosisPoolBalances(osmosis) {
+		starsAddr, acc, err := getAccount(addr)
+		if err != nil {
+			return snapshot, err
+		}
+		acc.OsmoAddress = addr
+		acc.OsmoPoolBalance = shares
+		acc.OsmosisLiquidityProvider = shares.IsPositive()
+		snapshot.Accounts[starsAddr] = acc
+	}
+
+	// regen stakers
+	regenStaked, regenSGDelegators, err := stakedBalances(regen, config.RegenValidator)
+	if err != nil {
+		return snapshot, err
+	}
+	for addr, staked := range regenStaked {
+		starsAddr, acc, err := getAccount(addr)
+		if err != nil {
+			return snapshot, err
+		}
+		acc.RegenAddress = addr
+		acc.RegenStakedBalance = staked
+		acc.RegenStaker = staked.GTE(config.MinStakedAmount)
+		acc.StargazeRegenDelegator = regenSGDelegators[addr]
+		snapshot.Accounts[starsAddr] = acc
+	}
+
+	// apply weights
+	points := make(map[string]sdk.Dec, len(snapshot.Accounts))
+	totalPoints := sdk.ZeroDec()
+	for addr, acc := range snapshot.Accounts {
+		p := acc.points(config.Weights)
+		if !p.IsPositive() {
+			delete(snapshot.Accounts, addr)
+			continue
+		}
+		points[addr] = p
+		totalPoints = totalPoints.Add(p)
+	}
+	if totalPoints.IsZero() {
+		return snapshot, nil
+	}
+	for addr, acc := range snapshot.Accounts {
+		acc.AirdropAmount = config.AirdropSupply.ToDec().Mul(points[addr]).Quo(totalPoints).TruncateInt()
+		snapshot.TotalStarsAirdropAmount = snapshot.TotalStarsAirdropAmount.Add(acc.AirdropAmount)
+		snapshot.Accounts[addr] = acc
+	}
+
+	return snapshot, nil
+}
+
+func (acc SnapshotAccount) points(weights SnapshotWeights) sdk.Dec {
+	p := sdk.ZeroDec()
+	categories := []struct {
+		eligible bool
+		weight   sdk.Dec
+	}{
+		{acc.AtomStaker, weights.AtomStaker},
+		{acc.OsmoStaker, weights.OsmoStaker},
+		{acc.OsmosisLiquidityProvider, weights.OsmosisLiquidityProvider},
+		{acc.RegenStaker, weights.RegenStaker},
+		{acc.StargazeHubDelegator, weights.StargazeDelegator},
+		{acc.StargazeOsmosisDelegator, weights.StargazeDelegator},
+		{acc.StargazeRegenDelegator, weights.StargazeDelegator},
+	}
+	for _, c := range categories {
+		if c.eligible {
+			p = p.Add(c.weight)
+		}
+	}
+	return p
+}
+
+// stakedBalances returns the staked tokens of every delegator and whether they delegate to the given validator
+func stakedBalances(genesis snapshotGenesis, stargazeValidator string) (map[string]sdk.Int, map[string]bool, error) {
+	type validator struct {
+		tokens sdk.Int
+		shares sdk.Dec
+	}
+	validators := make(map[string]validator)
+	for _, v := range genesis.AppState.Staking.Validators {
+		validators[v.OperatorAddress] = validator{tokens: v.Tokens, shares: v.DelegatorShares}
+	}
+
+	staked := make(map[string]sdk.Int)
+	sgDelegators := make(map[string]bool)
+	for _, d := range genesis.AppState.Staking.Delegations {
+		v, ok := validators[d.ValidatorAddress]
+		if !ok {
+			return nil, nil, fmt.Errorf("validator %s not found for delegation of %s", d.ValidatorAddress, d.DelegatorAddress)
+		}
+		// fields missing from the export are nil, treat them as zero
+		if v.shares.IsNil() || v.shares.IsZero() || d.Shares.IsNil() || d.Shares.IsZero() {
+			continue
+		}
+		if v.tokens.IsNil() {
+			return nil, nil, fmt.Errorf("tokens of validator %s missing from the staking export", d.ValidatorAddress)
+		}
+		tokens := d.Shares.MulInt(v.tokens).Quo(v.shares).TruncateInt()
+		if _, ok := staked[d.DelegatorAddress]; !ok {
+			staked[d.DelegatorAddress] = sdk.ZeroInt()
+		}
+		staked[d.DelegatorAddress] = staked[d.DelegatorAddress].Add(tokens)
+		if stargazeValidator != "" && d.ValidatorAddress == stargazeValidator && tokens.IsPositive() {
+			sgDelegators[d.DelegatorAddress] = true
+		}
+	}
+	return staked, sgDelegators, nil
+}
+
+// osmosisPoolBalances returns the liquidity pool shares held or locked by every account.
+// Module accounts are skipped, the lockup module account holds the shares of all locks
+// which are counted for their owners.
+func osmosisPoolBalances(genesis snapshotGenesis) map[string]sdk.Int {
+	moduleAccounts := make(map[string]bool)
+	for _, acc := range genesis.AppState.Auth.Accounts {
+		if acc.Type == moduleAccountType {
+			moduleAccounts[acc.BaseAccount.Address] = true
+		}
+	}
+
+	shares := make(map[string]sdk.Int)
+	add := func(addr string, coins sdk.Coins) {
+		for _, coin := range coins {
+			if !strings.HasPrefix(coin.Denom, osmosisPoolDenomPrefix) {
+				continue
+			}
+			if _, ok := shares[addr]; !ok {
+				shares[addr] = sdk.ZeroInt()
+			}
+			shares[addr] = shares[addr].Add(coin.Amount)
+		}
+	}
+	for _, balance := range genesis.AppState.Bank.Balances {
+		if moduleAccounts[balance.Address] {
+			continue
+		}
+		add(balance.Address, balance.Coins)
+	}
+	for _, lock := range genesis.AppState.Lockup.Locks {
+		add(lock.Owner, lock.Coins)
+	}
+	return shares
+}
+
+func convertToStarsAddress(addr string) (string, error) {
+	_, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return "", fmt.Errorf("invalid address %s: %w", addr, err)
+	}
+	return bech32.ConvertAndEncode(Bech32PrefixAccAddr, bz)
+}
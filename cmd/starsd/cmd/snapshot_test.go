@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportAirdropSnapshotCmd(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "snapshot.json")
+
+	cmd := ExportAirdropSnapshotCmd()
+	cmd.SetArgs([]string{
+		"testdata/hub_genesis.json",
+		"testdata/osmosis_genesis.json",
+		"testdata/regen_genesis.json",
+		output,
+		"--" + flagHubValidator, "cosmosvaloper1zqgpqyqszqgpqyqszqgpqyqszqgpqyqs9ezk5a",
+		"--" + flagOsmosisValidator, "osmovaloper1yqszqgpqyqszqgpqyqszqgpqyqszqgpqvcwn66",
+		"--" + flagAirdropSupply, "800",
+	})
+	cmd.SetOut(ioutil.Discard)
+	require.NoError(t, cmd.Execute())
+
+	bz, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	snapshot := Snapshot{}
+	require.NoError(t, json.Unmarshal(bz, &snapshot))
+	require.Len(t, snapshot.Accounts, 3)
+	require.Equal(t, sdk.NewInt(800), snapshot.TotalStarsAirdropAmount)
+
+	// hub and osmosis staker delegating to stargaze on both chains: 4 points
+	acc1 := snapshot.Accounts["stars1qyqszqgpqyqszqgpqyqszqgpqyqszqgpx0krxd"]
+	require.Equal(t, "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du", acc1.AtomAddress)
+	require.Equal(t, "osmo1qyqszqgpqyqszqgpqyqszqgpqyqszqgp6gjwmw", acc1.OsmoAddress)
+	require.True(t, acc1.AtomStaker)
+	require.True(t, acc1.OsmoStaker)
+	require.True(t, acc1.StargazeHubDelegator)
+	require.True(t, acc1.StargazeOsmosisDelegator)
+	require.False(t, acc1.OsmosisLiquidityProvider)
+	require.Equal(t, sdk.NewInt(1000), acc1.AtomStakedBalance)
+	require.Equal(t, sdk.NewInt(400), acc1.AirdropAmount)
+
+	// hub staker with a slashed validator and locked osmosis LP shares: 2 points
+	acc2 := snapshot.Accounts["stars1qgpqyqszqgpqyqszqgpqyqszqgpqyqszhtsxdm"]
+	require.True(t, acc2.AtomStaker)
+	require.False(t, acc2.StargazeHubDelegator)
+	require.True(t, acc2.OsmosisLiquidityProvider)
+	require.Equal(t, sdk.NewInt(500), acc2.AtomStakedBalance)
+	require.Equal(t, sdk.NewInt(20), acc2.OsmoPoolBalance)
+	require.Equal(t, sdk.NewInt(200), acc2.AirdropAmount)
+
+	// osmosis LP and regen staker: 2 points
+	acc3 := snapshot.Accounts["stars1qvpsxqcrqvpsxqcrqvpsxqcrqvpsxqcrkm3886"]
+	require.Equal(t, "regen1qvpsxqcrqvpsxqcrqvpsxqcrqvpsxqcra9dx60", acc3.RegenAddress)
+	require.True(t, acc3.RegenStaker)
+	require.False(t, acc3.StargazeRegenDelegator)
+	require.True(t, acc3.OsmosisLiquidityProvider)
+	require.Equal(t, sdk.NewInt(200), acc3.AirdropAmount)
+
+	// the lockup module account holding the locked shares gets nothing
+	require.NotContains(t, snapshot.Accounts, "stars1njty28rqtpw6n59sjj4esw76enp4mg6gzl2w2m")
+}
+
+func TestExportAirdropSnapshotWeights(t *testing.T) {
+	genesis := make([]snapshotGenesis, 3)
+	for i, file := range []string{"testdata/hub_genesis.json", "testdata/osmosis_genesis.json", "testdata/regen_genesis.json"} {
+		var err error
+		genesis[i], err = readSnapshotGenesis(file)
+		require.NoError(t, err)
+	}
+
+	// only hub stakers above 600 are eligible
+	snapshot, err := ExportAirdropSnapshot(genesis[0], genesis[1], genesis[2], SnapshotConfig{
+		AirdropSupply:   sdk.NewInt(1000),
+		MinStakedAmount: sdk.NewInt(600),
+		Weights: SnapshotWeights{
+			AtomStaker:               sdk.OneDec(),
+			OsmoStaker:               sdk.ZeroDec(),
+			OsmosisLiquidityProvider: sdk.ZeroDec(),
+			RegenStaker:              sdk.ZeroDec(),
+			StargazeDelegator:        sdk.ZeroDec(),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, snapshot.Accounts, 1)
+	require.Equal(t, sdk.NewInt(1000), snapshot.Accounts["stars1qyqszqgpqyqszqgpqyqszqgpqyqszqgpx0krxd"].AirdropAmount)
+}
+
+func TestStakedBalancesMissingFields(t *testing.T) {
+	parse := func(staking string) snapshotGenesis {
+		genesis := snapshotGenesis{}
+		require.NoError(t, json.Unmarshal([]byte(`{"app_state":{"staking":`+staking+`}}`), &genesis))
+		return genesis
+	}
+
+	// missing validator and delegation shares are skipped
+	staked, _, err := stakedBalances(parse(`{
+		"validators": [{"operator_address": "val1", "tokens": "100"}, {"operator_address": "val2", "tokens": "100", "delegator_shares": "100"}],
+		"delegations": [{"delegator_address": "del1", "validator_address": "val1", "shares": "10"}, {"delegator_address": "del2", "validator_address": "val2"}]
+	}`), "")
+	require.NoError(t, err)
+	require.Empty(t, staked)
+
+	// missing validator tokens are an error
+	_, _, err = stakedBalances(parse(`{
+		"validators": [{"operator_address": "val1", "delegator_shares": "100"}],
+		"delegations": [{"delegator_address": "del1", "validator_address": "val1", "shares": "10"}]
+	}`), "")
+	require.Error(t, err)
+}
@@ -21,6 +21,7 @@ func main() {
 		cosmoscmd.AddSubCmd(cmd.TestnetCmd(app.ModuleBasics)),
 		cosmoscmd.AddCustomInitCmd(cmd.InitCmd(app.ModuleBasics, app.DefaultNodeHome)),
 		cosmoscmd.AddSubCmd(cmd.PrepareGenesisCmd(app.DefaultNodeHome, app.ModuleBasics)),
+		cosmoscmd.AddSubCmd(cmd.ExportAirdropSnapshotCmd()),
 		cosmoscmd.AddSubCmd(tmcmds.RollbackStateCmd),
 		// this line is used by starport scaffolding # root/arguments
 	)
@@ -0,0 +1,23 @@
+package simapp
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	ibctesting "github.com/cosmos/ibc-go/testing"
+	"github.com/tendermint/spm/cosmoscmd"
+	"github.com/tendermint/tendermint/libs/log"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/public-awesome/stargaze/app"
+)
+
+// SetupTestingApp creates an application instance for the ibc-go testing framework,
+// to be assigned to ibctesting.DefaultTestingAppInit.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	db := tmdb.NewMemDB()
+	encoding := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	a := app.NewStargazeApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, app.DefaultNodeHome, 5, encoding,
+		simapp.EmptyAppOptions{})
+	return a.(*app.App), app.ModuleBasics.DefaultGenesis(encoding.Marshaler)
+}
@@ -3,21 +3,36 @@ package claim
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/public-awesome/stargaze/x/claim/keeper"
+	"github.com/public-awesome/stargaze/x/claim/types"
 )
 
-// EndBlocker called every block, process inflation, update validator set.
+// EndBlocker called every block, advances the airdrop lifecycle and ends the airdrop and campaigns once.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	err := k.EndCampaigns(ctx)
+	if err != nil {
+		panic(err)
+	}
+	err = k.StartAirdrop(ctx)
+	if err != nil {
+		panic(err)
+	}
+	state := k.GetAirdropState(ctx)
+	if state.Stage == types.AirdropStageEnded {
+		return
+	}
 	params := k.GetParams(ctx)
-	if !params.IsAirdropEnabled(ctx.BlockTime()) {
+	stage := params.AirdropStage(ctx.BlockTime())
+	if stage == state.Stage {
 		return
 	}
-	// End Airdrop
-	goneTime := ctx.BlockTime().Sub(params.AirdropStartTime)
-	if goneTime > params.DurationUntilDecay+params.DurationOfDecay {
+	if stage == types.AirdropStageEnded {
 		// airdrop time passed
 		err := k.EndAirdrop(ctx)
 		if err != nil {
 			panic(err)
 		}
+		return
 	}
+	state.Stage = stage
+	k.SetAirdropState(ctx, state)
 }
@@ -3,7 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	// "strings"
 
@@ -19,6 +21,11 @@ import (
 	"github.com/public-awesome/stargaze/x/claim/types"
 )
 
+const (
+	FlagInterval = "interval"
+	FlagCount    = "count"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group claim queries under a subcommand
@@ -35,6 +42,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdQueryClaimRecord(),
 		GetCmdQueryClaimableForAction(),
 		GetCmdQueryTotalClaimable(),
+		GetCmdQueryAirdropState(),
+		GetCmdQueryClaimableSchedule(),
+		GetCmdQueryCampaigns(),
+		GetCmdQueryCampaign(),
+		GetCmdQueryCampaignClaimRecord(),
+		GetCmdQueryClaimRecords(),
+		GetCmdQueryAirdropStats(),
+		GetCmdQueryClaimHistory(),
+		GetCmdQueryVestingEscrow(),
 	)
 	// this line is used by starport scaffolding # 1
 
@@ -210,3 +226,300 @@ $ %s query claim total-claimable osmo1ey69r37gfxvxg62sh4r0ktpuc46pzjrm23kcrx
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryAirdropState implements a command to return the airdrop lifecycle state.
+func GetCmdQueryAirdropState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop-state",
+		Short: "Query the airdrop lifecycle state",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAirdropStateRequest{}
+			res, err := queryClient.AirdropState(context.Background(), req)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.AirdropState)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClaimableSchedule implements a command to return the projected claimable amount of an address.
+func GetCmdQueryClaimableSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-schedule [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query an address' projected total claimable amount at future times",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an address' projected total claimable amount at future times, starting at the current block time
+
+Example:
+$ %s query claim claimable-schedule stars1ey69r37gfxvxg62sh4r0ktpuc46pzjrmz29g45 --interval 168h --count 10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			interval, err := cmd.Flags().GetDuration(FlagInterval)
+			if err != nil {
+				return err
+			}
+			count, err := cmd.Flags().GetUint32(FlagCount)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClaimableSchedule(context.Background(), &types.QueryClaimableScheduleRequest{
+				Address:  args[0],
+				Interval: interval,
+				Count:    count,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Duration(FlagInterval, time.Hour*24, "Time between two projections")
+	cmd.Flags().Uint32(FlagCount, 10, "Number of projections")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCampaigns implements a command to return all airdrop campaigns.
+func GetCmdQueryCampaigns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "campaigns",
+		Short: "Query all airdrop campaigns",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Campaigns(context.Background(), &types.QueryCampaignsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "campaigns")
+	return cmd
+}
+
+// GetCmdQueryCampaign implements a command to return an airdrop campaign.
+func GetCmdQueryCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "campaign [id]",
+		Short: "Query an airdrop campaign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid campaign id %s", args[0])
+			}
+
+			res, err := queryClient.Campaign(context.Background(), &types.QueryCampaignRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Campaign)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCampaignClaimRecord implements the query campaign claim record command.
+func GetCmdQueryCampaignClaimRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "campaign-claim-record [campaign-id] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the claim record of a campaign for an account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the claim record of a campaign for an account.
+
+Example:
+$ %s query claim campaign-claim-record 1 stars1ey69r37gfxvxg62sh4r0ktpuc46pzjrmz29g45
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid campaign id %s", args[0])
+			}
+
+			res, err := queryClient.CampaignClaimRecord(context.Background(), &types.QueryCampaignClaimRecordRequest{
+				CampaignId: id,
+				Address:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryClaimRecords implements a command to list all claim records.
+func GetCmdQueryClaimRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-records",
+		Short: "Query all claim records of the airdrop",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClaimRecords(context.Background(), &types.QueryClaimRecordsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "claim-records")
+	return cmd
+}
+
+// GetCmdQueryAirdropStats implements a command to return the aggregate airdrop progress.
+func GetCmdQueryAirdropStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop-stats",
+		Short: "Query the total allocated and claimed amounts of the airdrop",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AirdropStats(context.Background(), &types.QueryAirdropStatsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryClaimHistory implements the query claim history command.
+func GetCmdQueryClaimHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-history [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the amounts an account received for each claimed action.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amounts an account received for each claimed action, from the airdrop and all campaigns.
+
+Example:
+$ %s query claim claim-history stars1ey69r37gfxvxg62sh4r0ktpuc46pzjrmz29g45
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimHistory(context.Background(), &types.QueryClaimHistoryRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVestingEscrow implements the query vesting escrow command.
+func GetCmdQueryVestingEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-escrow [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the claimed amounts vesting in the module for an account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the claimed amounts vesting in the module for an account and the amount it can release now.
+
+Example:
+$ %s query claim vesting-escrow stars1ey69r37gfxvxg62sh4r0ktpuc46pzjrmz29g45
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VestingEscrow(context.Background(), &types.QueryVestingEscrowRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -26,6 +26,10 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdInitialClaim())
+	cmd.AddCommand(CmdClaimFor())
+	cmd.AddCommand(CmdCreateCampaign())
+	cmd.AddCommand(CmdReleaseVesting())
+	cmd.AddCommand(CmdTransferClaimRecord())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
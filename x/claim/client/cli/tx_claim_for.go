@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+func CmdClaimFor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-for [address] [action]",
+		Short: "Claim an action on behalf of an address",
+		Long:  "Claim an action on behalf of an address. Only allowed claimers can claim for their configured action.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			action, err := parseAction(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimFor(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				action,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseAction accepts either the action name (ActionMintNFT) or its number (2)
+func parseAction(arg string) (types.Action, error) {
+	if action, ok := types.Action_value[arg]; ok {
+		return types.Action(action), nil
+	}
+	action, err := strconv.ParseInt(arg, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid action %s", arg)
+	}
+	if _, ok := types.Action_name[int32(action)]; !ok {
+		return 0, fmt.Errorf("invalid action %s", arg)
+	}
+	return types.Action(action), nil
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// CmdSubmitClaimRecordsUpdateProposal implements the command to submit a claim records update proposal
+func CmdSubmitClaimRecordsUpdateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-records-update [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add, adjust or revoke claim records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add, adjust or revoke airdrop claim records along with an initial deposit.
+The difference in unclaimed amounts is minted to or burned from the claim module account.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal claim-records-update <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Claim records update",
+  "description": "Add a missed address and revoke a duplicate one",
+  "claim_records": [
+    {"address": "stars1ey69r37gfxvxg62sh4r0ktpuc46pzjrmz29g45", "initial_claimable_amount": [{"denom": "ustars", "amount": "1000000"}]}
+  ],
+  "revoked_addresses": ["stars1s5afhd6gxevu37mkqcvvsj8qeylhn0rz0x24v8"],
+  "deposit": "1000ustars"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := parseClaimRecordsUpdateProposalWithDeposit(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewClaimRecordsUpdateProposal(proposal.Title, proposal.Description, proposal.ClaimRecords, proposal.RevokedAddresses)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// parseClaimRecordsUpdateProposalWithDeposit reads and parses a ClaimRecordsUpdateProposalWithDeposit from a file.
+func parseClaimRecordsUpdateProposalWithDeposit(cdc codec.JSONCodec, proposalFile string) (types.ClaimRecordsUpdateProposalWithDeposit, error) {
+	proposal := types.ClaimRecordsUpdateProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+func CmdCreateCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-campaign [campaign-file]",
+		Short: "Create and fund an airdrop campaign",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create an airdrop campaign from a JSON file. The sender funds the sum of all
+initial claimable amounts and must be an allowed campaign creator.
+
+Example:
+$ %s tx claim create-campaign campaign.json --from mykey
+
+Where campaign.json contains:
+{
+  "name": "nft creators",
+  "denom": "ustars",
+  "start_time": "2022-01-01T00:00:00Z",
+  "duration_until_decay": "2592000s",
+  "duration_of_decay": "5184000s",
+  "decay_function": {"type": "DecayTypeLinear"},
+  "action_weights": [{"action": "ActionMintNFT", "weight": "1"}],
+  "claim_records": [
+    {"address": "stars1ey69r37gfxvxg62sh4r0ktpuc46pzjrmz29g45", "initial_claimable_amount": [{"denom": "ustars", "amount": "1000000"}]}
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgCreateCampaign{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return err
+			}
+			msg.Sender = clientCtx.GetFromAddress().String()
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
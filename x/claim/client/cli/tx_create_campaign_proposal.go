@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// CmdSubmitCreateCampaignProposal implements the command to submit a create campaign proposal
+func CmdSubmitCreateCampaignProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-campaign [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to create a campaign funded from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to create an airdrop campaign along with an initial deposit.
+The sum of all initial claimable amounts is taken from the community pool.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal create-campaign <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "NFT creators campaign",
+  "description": "Reward the first NFT creators",
+  "name": "nft creators",
+  "denom": "ustars",
+  "start_time": "2022-01-01T00:00:00Z",
+  "duration_until_decay": "2592000s",
+  "duration_of_decay": "5184000s",
+  "decay_function": {"type": "DecayTypeLinear"},
+  "action_weights": [{"action": "ActionMintNFT", "weight": "1"}],
+  "claim_records": [
+    {"address": "stars1ey69r37gfxvxg62sh4r0ktpuc46pzjrmz29g45", "initial_claimable_amount": [{"denom": "ustars", "amount": "1000000"}]}
+  ],
+  "deposit": "1000ustars"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := parseCreateCampaignProposalWithDeposit(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCreateCampaignProposal(
+				proposal.Title, proposal.Description,
+				proposal.Name, proposal.Denom, proposal.StartTime,
+				proposal.DurationUntilDecay, proposal.DurationOfDecay,
+				proposal.DecayFunction, proposal.ActionWeights, proposal.ClaimRecords,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// parseCreateCampaignProposalWithDeposit reads and parses a CreateCampaignProposalWithDeposit from a file.
+func parseCreateCampaignProposalWithDeposit(cdc codec.JSONCodec, proposalFile string) (types.CreateCampaignProposalWithDeposit, error) {
+	proposal := types.CreateCampaignProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/public-awesome/stargaze/x/claim/client/cli"
+	"github.com/public-awesome/stargaze/x/claim/client/rest"
+)
+
+// ProposalHandler is the claim records update proposal handler and CreateCampaignProposalHandler
+// the create campaign proposal handler.
+var (
+	ProposalHandler               = govclient.NewProposalHandler(cli.CmdSubmitClaimRecordsUpdateProposal, rest.ProposalRESTHandler)
+	CreateCampaignProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCreateCampaignProposal, rest.CreateCampaignProposalRESTHandler)
+)
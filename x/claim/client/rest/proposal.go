@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// ClaimRecordsUpdateProposalReq defines a claim records update proposal request body.
+type ClaimRecordsUpdateProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title            string              `json:"title" yaml:"title"`
+	Description      string              `json:"description" yaml:"description"`
+	ClaimRecords     []types.ClaimRecord `json:"claim_records" yaml:"claim_records"`
+	RevokedAddresses []string            `json:"revoked_addresses" yaml:"revoked_addresses"`
+	Proposer         sdk.AccAddress      `json:"proposer" yaml:"proposer"`
+	Deposit          sdk.Coins           `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the claim records update REST handler with a given sub-route.
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "claim_records_update",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ClaimRecordsUpdateProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewClaimRecordsUpdateProposal(req.Title, req.Description, req.ClaimRecords, req.RevokedAddresses)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// CreateCampaignProposalReq defines a create campaign proposal request body.
+type CreateCampaignProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title              string               `json:"title" yaml:"title"`
+	Description        string               `json:"description" yaml:"description"`
+	Name               string               `json:"name" yaml:"name"`
+	Denom              string               `json:"denom" yaml:"denom"`
+	StartTime          time.Time            `json:"start_time" yaml:"start_time"`
+	DurationUntilDecay time.Duration        `json:"duration_until_decay" yaml:"duration_until_decay"`
+	DurationOfDecay    time.Duration        `json:"duration_of_decay" yaml:"duration_of_decay"`
+	DecayFunction      types.DecayFunction  `json:"decay_function" yaml:"decay_function"`
+	ActionWeights      []types.ActionWeight `json:"action_weights" yaml:"action_weights"`
+	ClaimRecords       []types.ClaimRecord  `json:"claim_records" yaml:"claim_records"`
+	Proposer           sdk.AccAddress       `json:"proposer" yaml:"proposer"`
+	Deposit            sdk.Coins            `json:"deposit" yaml:"deposit"`
+}
+
+// CreateCampaignProposalRESTHandler returns a ProposalRESTHandler that exposes the create campaign REST handler with a given sub-route.
+func CreateCampaignProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "create_campaign",
+		Handler:  postCreateCampaignProposalHandlerFn(clientCtx),
+	}
+}
+
+func postCreateCampaignProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateCampaignProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCreateCampaignProposal(
+			req.Title, req.Description,
+			req.Name, req.Denom, req.StartTime,
+			req.DurationUntilDecay, req.DurationOfDecay,
+			req.DecayFunction, req.ActionWeights, req.ClaimRecords,
+		)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
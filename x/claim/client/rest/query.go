@@ -0,0 +1,171 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
+	r.HandleFunc(
+		"/claim/parameters",
+		queryParamsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/claim/claim_record/{address}",
+		queryClaimRecordHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/claim/claimable_for_action/{address}/{action}",
+		queryClaimableForActionHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/claim/total_claimable/{address}",
+		queryTotalClaimableHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/claim/module_account_balance",
+		queryModuleAccountBalanceHandlerFn(clientCtx),
+	).Methods("GET")
+}
+
+func queryParamsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(route, nil)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func queryClaimRecordHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryClaimRecord)
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(types.QueryClaimRecordRequest{Address: mux.Vars(r)["address"]})
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func queryClaimableForActionHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryClaimableForAction)
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		action, err := parseAction(vars["action"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(types.QueryClaimableForActionRequest{Address: vars["address"], Action: action})
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func queryTotalClaimableHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTotalClaimable)
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(types.QueryTotalClaimableRequest{Address: mux.Vars(r)["address"]})
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func queryModuleAccountBalanceHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryModuleAccountBalance)
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(route, nil)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+// parseAction parses an action from its name, e.g. ActionInitialClaim, or its number
+func parseAction(s string) (types.Action, error) {
+	if action, ok := types.Action_value[s]; ok {
+		return types.Action(action), nil
+	}
+	action, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid action: %s", s)
+	}
+	if _, ok := types.Action_name[int32(action)]; !ok {
+		return 0, fmt.Errorf("invalid action: %s", s)
+	}
+	return types.Action(action), nil
+}
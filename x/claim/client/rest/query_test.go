@@ -0,0 +1,129 @@
+//go:build norace
+// +build norace
+
+package rest_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/public-awesome/stargaze/testutil/network"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+	cfg     network.Config
+	network *network.Network
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+
+	genesisState := cfg.GenesisState
+	cfg.NumValidators = 1
+
+	var claimData types.GenesisState
+	s.Require().NoError(cfg.Codec.UnmarshalJSON(genesisState[types.ModuleName], &claimData))
+
+	claimData.Params.AirdropEnabled = true
+	claimData.Params.ClaimDenom = cfg.BondDenom
+	claimData.ModuleAccountBalance = sdk.NewInt64Coin(cfg.BondDenom, 1000)
+	claimData.ClaimRecords = []types.ClaimRecord{{
+		Address:                claimAddress,
+		InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(cfg.BondDenom, 1000)),
+		ActionCompleted:        []bool{false, false, false, false, false},
+	}}
+
+	claimDataBz, err := cfg.Codec.MarshalJSON(&claimData)
+	s.Require().NoError(err)
+	genesisState[types.ModuleName] = claimDataBz
+	cfg.GenesisState = genesisState
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err = s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+const claimAddress = "cosmos1ey69r37gfxvxg62sh4r0ktpuc46pzjrmz29g45"
+
+func (s *IntegrationTestSuite) TestQueryLegacyREST() {
+	val := s.network.Validators[0]
+	baseURL := val.APIAddress
+	testCases := []struct {
+		name     string
+		url      string
+		respType interface{}
+		expected interface{}
+	}{
+		{
+			"request claim record",
+			fmt.Sprintf("%s/claim/claim_record/%s", baseURL, claimAddress),
+			&types.ClaimRecord{},
+			&types.ClaimRecord{
+				Address:                claimAddress,
+				InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1000)),
+				ActionCompleted:        []bool{false, false, false, false, false},
+			},
+		},
+		{
+			"request claimable for action by name",
+			fmt.Sprintf("%s/claim/claimable_for_action/%s/ActionInitialClaim", baseURL, claimAddress),
+			&sdk.Coins{},
+			&sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 200)},
+		},
+		{
+			"request claimable for action by number",
+			fmt.Sprintf("%s/claim/claimable_for_action/%s/%d", baseURL, claimAddress, types.ActionVote),
+			&sdk.Coins{},
+			&sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 200)},
+		},
+		{
+			"request total claimable",
+			fmt.Sprintf("%s/claim/total_claimable/%s", baseURL, claimAddress),
+			&sdk.Coins{},
+			&sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 1000)},
+		},
+		{
+			"request module account balance",
+			fmt.Sprintf("%s/claim/module_account_balance", baseURL),
+			&sdk.Coins{},
+			&sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 1000)},
+		},
+	}
+	for _, tc := range testCases {
+		resp, err := rest.GetRequest(tc.url)
+		s.Run(tc.name, func() {
+			s.Require().NoError(err)
+			bz, err := rest.ParseResponseWithHeight(val.ClientCtx.LegacyAmino, resp)
+			s.Require().NoError(err)
+			s.Require().NoError(val.ClientCtx.LegacyAmino.UnmarshalJSON(bz, tc.respType))
+			s.Require().Equal(tc.expected, tc.respType)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryLegacyRESTInvalid() {
+	val := s.network.Validators[0]
+	baseURL := val.APIAddress
+
+	resp, err := rest.GetRequest(fmt.Sprintf("%s/claim/claimable_for_action/%s/ActionUnknown", baseURL, claimAddress))
+	s.Require().NoError(err)
+	s.Require().Contains(string(resp), "invalid action")
+
+	resp, err = rest.GetRequest(fmt.Sprintf("%s/claim/total_claimable/invalid", baseURL))
+	s.Require().NoError(err)
+	s.Require().Contains(string(resp), "error")
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/public-awesome/stargaze/x/claim/keeper"
 	"github.com/public-awesome/stargaze/x/claim/types"
 )
@@ -20,6 +22,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgInitialClaim:
 			res, err := msgServer.InitialClaim(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimFor:
+			res, err := msgServer.ClaimFor(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateCampaign:
+			res, err := msgServer.CreateCampaign(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReleaseVesting:
+			res, err := msgServer.ReleaseVesting(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferClaimRecord:
+			res, err := msgServer.TransferClaimRecord(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -27,3 +41,43 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+// NewClaimRecordsUpdateProposalHandler handles the claim module governance proposals
+func NewClaimRecordsUpdateProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ClaimRecordsUpdateProposal:
+			return keeper.HandleClaimRecordsUpdateProposal(ctx, k, c)
+
+		case *types.CreateCampaignProposal:
+			return keeper.HandleCreateCampaignProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+// NewParamChangeProposalHandler wraps the params module proposal handler and rejects param
+// changes that leave the claim params invalid as a whole.
+func NewParamChangeProposalHandler(k keeper.Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+		c, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range c.Changes {
+			if change.Subspace != types.ModuleName {
+				continue
+			}
+			if err := k.ValidateParams(ctx); err != nil {
+				return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "invalid %s params: %s", types.ModuleName, err)
+			}
+			break
+		}
+		return nil
+	}
+}
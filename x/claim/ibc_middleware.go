@@ -0,0 +1,157 @@
+package claim
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+	"github.com/public-awesome/stargaze/x/claim/keeper"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the transfer module and completes the initial claim of
+// the receiver of an ICS-20 transfer carrying the claim memo
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given application
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// transferPacketData is the ICS-20 packet data including the memo sent by
+// counterparty chains supporting it, even when empty
+type transferPacketData struct {
+	Denom    string  `json:"denom"`
+	Amount   string  `json:"amount"`
+	Sender   string  `json:"sender"`
+	Receiver string  `json:"receiver"`
+	Memo     *string `json:"memo"`
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyVersion string) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. The memo is stripped before
+// the transfer module decodes the packet, and once the transfer succeeded the
+// claim memo completes the initial claim of the receiver.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transferPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil || data.Memo == nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	amount, err := strconv.ParseUint(data.Amount, 10, 64)
+	if err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	packet.Data = transfertypes.NewFungibleTokenPacketData(data.Denom, amount, data.Sender, data.Receiver).GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() || *data.Memo != types.IBCClaimMemo {
+		return ack
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return ack
+	}
+	// a failed claim never fails the transfer
+	cacheCtx, write := ctx.CacheContext()
+	if err := im.claim(cacheCtx, receiver); err != nil {
+		im.keeper.Logger(ctx).Error("failed to claim from ibc transfer", "receiver", data.Receiver, "err", err)
+		return ack
+	}
+	write()
+	return ack
+}
+
+// claim completes the initial claim of an address
+func (im IBCMiddleware) claim(ctx sdk.Context, addr sdk.AccAddress) error {
+	params := im.keeper.GetParams(ctx)
+	if !params.IsAirdropEnabled(ctx.BlockTime()) && !im.keeper.IsAnyCampaignActive(ctx) {
+		return types.ErrAirdropNotEnabled
+	}
+	_, err := im.keeper.ClaimCoinsForAction(ctx, addr, types.ActionInitialClaim)
+	return err
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
@@ -0,0 +1,167 @@
+package claim_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/public-awesome/stargaze/app"
+	"github.com/public-awesome/stargaze/testutil/simapp"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+type IBCMiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func (suite *IBCMiddlewareTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = simapp.SetupTestingApp
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+
+	// start the airdrop on chain B
+	stargaze := suite.chainB.App.(*app.App)
+	ctx := suite.chainB.GetContext()
+	stargaze.ClaimKeeper.CreateModuleAccount(ctx, sdk.NewCoin(types.DefaultClaimDenom, sdk.NewInt(10000000)))
+	stargaze.ClaimKeeper.SetParams(ctx, types.Params{
+		AirdropEnabled:     true,
+		AirdropStartTime:   ctx.BlockTime(),
+		DurationUntilDecay: time.Hour,
+		DurationOfDecay:    time.Hour,
+		ClaimDenom:         types.DefaultClaimDenom,
+	})
+}
+
+func TestIBCMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCMiddlewareTestSuite))
+}
+
+// receivePacket relays an ICS-20 packet from chain A to chain B, with a memo
+// field as sent by counterparty chains supporting it when memo is not nil
+func (suite *IBCMiddlewareTestSuite) receivePacket(receiver sdk.AccAddress, memo *string) {
+	data := transfertypes.NewFungibleTokenPacketData(
+		sdk.DefaultBondDenom, 100, suite.chainA.SenderAccount.GetAddress().String(), receiver.String(),
+	).GetBytes()
+	if memo != nil {
+		var err error
+		data, err = json.Marshal(map[string]string{
+			"denom":    sdk.DefaultBondDenom,
+			"amount":   "100",
+			"sender":   suite.chainA.SenderAccount.GetAddress().String(),
+			"receiver": receiver.String(),
+			"memo":     *memo,
+		})
+		suite.Require().NoError(err)
+	}
+
+	sequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(
+		suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+	)
+	suite.Require().True(found)
+	packet := channeltypes.NewPacket(
+		data, sequence,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100), 0,
+	)
+	suite.Require().NoError(suite.path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(suite.path.EndpointB.RecvPacket(packet))
+
+	ackBz, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(
+		suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+	)
+	suite.Require().True(found)
+	// the transfer succeeds whether or not the claim does
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), ackBz)
+}
+
+func (suite *IBCMiddlewareTestSuite) TestOnRecvPacket() {
+	stargaze := suite.chainB.App.(*app.App)
+	voucher := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+
+	records := []types.ClaimRecord{}
+	addrs := make([]sdk.AccAddress, 4)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress([]byte{byte(i + 1), 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+		records = append(records, types.ClaimRecord{
+			Address:                addrs[i].String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		})
+	}
+	err := stargaze.ClaimKeeper.SetClaimRecords(suite.chainB.GetContext(), records)
+	suite.Require().NoError(err)
+
+	memo := func(s string) *string { return &s }
+	tests := []struct {
+		name    string
+		addr    sdk.AccAddress
+		memo    *string
+		claimed int64
+	}{
+		{
+			name: "no memo field",
+			addr: addrs[0],
+		},
+		{
+			name: "empty memo",
+			addr: addrs[1],
+			memo: memo(""),
+		},
+		{
+			name: "unrecognized memo",
+			addr: addrs[2],
+			memo: memo("hello"),
+		},
+		{
+			name:    "claim memo",
+			addr:    addrs[3],
+			memo:    memo(types.IBCClaimMemo),
+			claimed: 200,
+		},
+		{
+			name: "claim memo twice",
+			addr: addrs[3],
+			memo: memo(types.IBCClaimMemo),
+		},
+	}
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			ctx := suite.chainB.GetContext()
+			balances := stargaze.BankKeeper.GetAllBalances(ctx, tt.addr)
+			suite.receivePacket(tt.addr, tt.memo)
+
+			ctx = suite.chainB.GetContext()
+			received := stargaze.BankKeeper.GetAllBalances(ctx, tt.addr).Sub(balances)
+			suite.Require().Equal(int64(100), received.AmountOf(voucher).Int64())
+			suite.Require().Equal(tt.claimed, received.AmountOf(types.DefaultClaimDenom).Int64())
+
+			record, err := stargaze.ClaimKeeper.GetClaimRecord(ctx, tt.addr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tt.memo != nil && *tt.memo == types.IBCClaimMemo, record.ActionCompleted[types.ActionInitialClaim])
+		})
+	}
+}
@@ -0,0 +1,411 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// GetNextCampaignID returns the id of the next campaign
+func (k Keeper) GetNextCampaignID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextCampaignIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextCampaignID sets the id of the next campaign
+func (k Keeper) SetNextCampaignID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextCampaignIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetCampaign sets a campaign in store and keeps it in the active campaign index until it ended
+func (k Keeper) SetCampaign(ctx sdk.Context, campaign types.Campaign) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CampaignKey(campaign.Id), k.cdc.MustMarshal(&campaign))
+	if campaign.Ended {
+		store.Delete(types.ActiveCampaignKey(campaign.Id))
+	} else {
+		store.Set(types.ActiveCampaignKey(campaign.Id), []byte{0x01})
+	}
+}
+
+// GetCampaign returns a campaign by id
+func (k Keeper) GetCampaign(ctx sdk.Context, id uint64) (types.Campaign, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CampaignKey(id))
+	if bz == nil {
+		return types.Campaign{}, false
+	}
+	var campaign types.Campaign
+	k.cdc.MustUnmarshal(bz, &campaign)
+	return campaign, true
+}
+
+// IterateCampaigns iterates over all campaigns ordered by id, stopping when the callback returns true
+func (k Keeper) IterateCampaigns(ctx sdk.Context, cb func(campaign types.Campaign) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CampaignsStorePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var campaign types.Campaign
+		k.cdc.MustUnmarshal(iterator.Value(), &campaign)
+		if cb(campaign) {
+			break
+		}
+	}
+}
+
+// IterateActiveCampaigns iterates over the campaigns which didn't end yet ordered by id, stopping
+// when the callback returns true. Ended campaigns are not visited.
+func (k Keeper) IterateActiveCampaigns(ctx sdk.Context, cb func(campaign types.Campaign) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ActiveCampaignsStorePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(types.ActiveCampaignsStorePrefix):])
+		campaign, found := k.GetCampaign(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("active campaign %d not found", id))
+		}
+		if cb(campaign) {
+			break
+		}
+	}
+}
+
+// GetAllCampaigns returns all campaigns
+func (k Keeper) GetAllCampaigns(ctx sdk.Context) []types.Campaign {
+	campaigns := []types.Campaign{}
+	k.IterateCampaigns(ctx, func(campaign types.Campaign) bool {
+		campaigns = append(campaigns, campaign)
+		return false
+	})
+	return campaigns
+}
+
+// CampaignBalances returns the sum of the balances of all campaigns held by the module account
+func (k Keeper) CampaignBalances(ctx sdk.Context) sdk.Coins {
+	balances := sdk.Coins{}
+	k.IterateCampaigns(ctx, func(campaign types.Campaign) bool {
+		balances = balances.Add(campaign.Balance)
+		return false
+	})
+	return balances
+}
+
+// IsAnyCampaignActive returns true if any campaign can be claimed from at the block time
+func (k Keeper) IsAnyCampaignActive(ctx sdk.Context) bool {
+	active := false
+	k.IterateActiveCampaigns(ctx, func(campaign types.Campaign) bool {
+		active = campaign.IsActive(ctx.BlockTime())
+		return active
+	})
+	return active
+}
+
+// SetCampaignClaimRecord sets a claim record of a campaign in store
+func (k Keeper) SetCampaignClaimRecord(ctx sdk.Context, campaignID uint64, claimRecord types.ClaimRecord) error {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.CampaignClaimRecordsPrefix(campaignID))
+
+	bz, err := proto.Marshal(&claimRecord)
+	if err != nil {
+		return err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(claimRecord.Address)
+	if err != nil {
+		return err
+	}
+
+	prefixStore.Set(addr, bz)
+	return nil
+}
+
+// DeleteCampaignClaimRecord removes the claim record of a campaign for a specific address
+func (k Keeper) DeleteCampaignClaimRecord(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.CampaignClaimRecordsPrefix(campaignID))
+	prefixStore.Delete(addr)
+}
+
+// GetCampaignClaimRecord returns the claim record of a campaign for a specific address
+func (k Keeper) GetCampaignClaimRecord(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress) (types.ClaimRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.CampaignClaimRecordsPrefix(campaignID))
+	bz := prefixStore.Get(addr)
+	if bz == nil {
+		return types.ClaimRecord{}, nil
+	}
+
+	claimRecord := types.ClaimRecord{}
+	err := proto.Unmarshal(bz, &claimRecord)
+	if err != nil {
+		return types.ClaimRecord{}, err
+	}
+	return claimRecord, nil
+}
+
+// CampaignClaimRecords returns the claim records of a campaign
+func (k Keeper) CampaignClaimRecords(ctx sdk.Context, campaignID uint64) []types.ClaimRecord {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.CampaignClaimRecordsPrefix(campaignID))
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	claimRecords := []types.ClaimRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		claimRecord := types.ClaimRecord{}
+		err := proto.Unmarshal(iterator.Value(), &claimRecord)
+		if err != nil {
+			panic(err)
+		}
+		claimRecords = append(claimRecords, claimRecord)
+	}
+	return claimRecords
+}
+
+// AllCampaignClaimRecords returns the claim records of all campaigns for genesis export
+func (k Keeper) AllCampaignClaimRecords(ctx sdk.Context) []types.CampaignClaimRecord {
+	records := []types.CampaignClaimRecord{}
+	k.IterateCampaigns(ctx, func(campaign types.Campaign) bool {
+		for _, claimRecord := range k.CampaignClaimRecords(ctx, campaign.Id) {
+			records = append(records, types.CampaignClaimRecord{CampaignId: campaign.Id, ClaimRecord: claimRecord})
+		}
+		return false
+	})
+	return records
+}
+
+func (k Keeper) clearCampaignClaimRecords(ctx sdk.Context, campaignID uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CampaignClaimRecordsPrefix(campaignID))
+	defer iterator.Close()
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+		count++
+	}
+	return count
+}
+
+// CreateCampaign funds a new campaign from the creator account and stores its claim records. Only the
+// CampaignCreators param allowlist, changed by param change proposals, is controlled by governance here.
+// Campaigns funded from the community pool are created by a CreateCampaignProposal instead.
+func (k Keeper) CreateCampaign(ctx sdk.Context, msg *types.MsgCreateCampaign) (uint64, error) {
+	params := k.GetParams(ctx)
+	if !params.IsCampaignCreator(msg.Sender) {
+		return 0, sdkerrors.Wrapf(types.ErrUnauthorizedCampaignCreator, "%s is not allowed to create campaigns", msg.Sender)
+	}
+	funder, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return 0, err
+	}
+	return k.createCampaign(ctx, msg, func(total sdk.Coins) error {
+		return k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, total)
+	})
+}
+
+// createCampaign stores a new campaign and its claim records after the fund callback moved the sum
+// of all initial claimable amounts to the module account.
+func (k Keeper) createCampaign(ctx sdk.Context, msg *types.MsgCreateCampaign, fund func(total sdk.Coins) error) (uint64, error) {
+	startTime := msg.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+	if startTime.Before(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidCampaign, "start time %s is before the block time", startTime)
+	}
+
+	total := msg.TotalClaimable()
+	err := fund(sdk.NewCoins(total))
+	if err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextCampaignID(ctx)
+	k.SetNextCampaignID(ctx, id+1)
+	k.SetCampaign(ctx, types.Campaign{
+		Id:                 id,
+		Name:               msg.Name,
+		Denom:              msg.Denom,
+		StartTime:          startTime,
+		DurationUntilDecay: msg.DurationUntilDecay,
+		DurationOfDecay:    msg.DurationOfDecay,
+		DecayFunction:      msg.DecayFunction,
+		ActionWeights:      msg.ActionWeights,
+		Funder:             msg.Sender,
+		Balance:            total,
+	})
+	for _, record := range msg.ClaimRecords {
+		record.ActionCompleted = make([]bool, len(types.Action_name))
+		err = k.SetCampaignClaimRecord(ctx, id, record)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeNewCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+		),
+	})
+	return id, nil
+}
+
+// GetCampaignClaimableAmountForAction returns claimable amount of a campaign for a specific action done by an address
+func (k Keeper) GetCampaignClaimableAmountForAction(ctx sdk.Context, campaign types.Campaign, addr sdk.AccAddress, action types.Action) (sdk.Coins, error) {
+	if !campaign.IsActive(ctx.BlockTime()) {
+		return sdk.Coins{}, nil
+	}
+	claimRecord, err := k.GetCampaignClaimRecord(ctx, campaign.Id, addr)
+	if err != nil {
+		return nil, err
+	}
+	if claimRecord.Address == "" || claimRecord.ActionCompleted[action] {
+		return sdk.Coins{}, nil
+	}
+
+	initial := initialClaimableForAction(campaign.EffectiveActionWeights(), claimRecord, action)
+	elapsed := ctx.BlockTime().Sub(campaign.StartTime)
+	claimable := decayClaimable(initial, elapsed, campaign.DurationUntilDecay, campaign.DurationOfDecay, campaign.DecayFunction)
+
+	// never pay out more than the campaign holds
+	if amount := claimable.AmountOf(campaign.Denom); amount.GT(campaign.Balance.Amount) {
+		claimable = sdk.NewCoins(campaign.Balance)
+	}
+	return claimable, nil
+}
+
+// claimCampaignsForAction claims all active campaigns of an address for an action. Only the active
+// campaign index is visited, so the cost of the claim hooks doesn't grow with the ended campaigns.
+func (k Keeper) claimCampaignsForAction(ctx sdk.Context, addr sdk.AccAddress, action types.Action) (sdk.Coins, error) {
+	var active []types.Campaign
+	k.IterateActiveCampaigns(ctx, func(campaign types.Campaign) bool {
+		// skip campaigns which didn't start yet or wait for EndCampaigns
+		if campaign.IsActive(ctx.BlockTime()) {
+			active = append(active, campaign)
+		}
+		return false
+	})
+
+	claimed := sdk.Coins{}
+	for _, campaign := range active {
+		amount, err := k.claimCampaignForAction(ctx, campaign, addr, action)
+		if err != nil {
+			return nil, err
+		}
+		claimed = claimed.Add(amount...)
+	}
+	return claimed, nil
+}
+
+func (k Keeper) claimCampaignForAction(ctx sdk.Context, campaign types.Campaign, addr sdk.AccAddress, action types.Action) (sdk.Coins, error) {
+	claimableAmount, err := k.GetCampaignClaimableAmountForAction(ctx, campaign, addr, action)
+	if err != nil || claimableAmount.Empty() {
+		return claimableAmount, err
+	}
+
+	claimRecord, err := k.GetCampaignClaimRecord(ctx, campaign.Id, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.sendClaimedCoins(ctx, addr, claimableAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	claimRecord.ActionCompleted[action] = true
+	err = k.SetCampaignClaimRecord(ctx, campaign.Id, claimRecord)
+	if err != nil {
+		return nil, err
+	}
+	err = k.recordClaim(ctx, campaign.Id, addr, action, claimableAmount)
+	if err != nil {
+		return nil, err
+	}
+	campaign.Balance = campaign.Balance.Sub(sdk.NewCoin(campaign.Denom, claimableAmount.AmountOf(campaign.Denom)))
+	k.SetCampaign(ctx, campaign)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaim,
+			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, action.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimableAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyCampaignID, fmt.Sprintf("%d", campaign.Id)),
+		),
+	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventClaim{
+		Address:    addr.String(),
+		Action:     action,
+		Amount:     claimableAmount,
+		CampaignId: campaign.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimableAmount, nil
+}
+
+// EndCampaign sweeps the unclaimed balance of a campaign to the community pool, clears its
+// claim records and marks it as ended. It is a no-op if the campaign already ended.
+func (k Keeper) EndCampaign(ctx sdk.Context, campaign types.Campaign) error {
+	if campaign.Ended {
+		return nil
+	}
+	swept := sdk.NewCoins(campaign.Balance)
+	if !swept.Empty() {
+		err := k.distrKeeper.FundCommunityPool(ctx, swept, k.GetModuleAccountAddress(ctx))
+		if err != nil {
+			return err
+		}
+	}
+	cleared := k.clearCampaignClaimRecords(ctx, campaign.Id)
+
+	campaign.Ended = true
+	campaign.Balance = sdk.NewCoin(campaign.Denom, sdk.ZeroInt())
+	k.SetCampaign(ctx, campaign)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCampaignEnded,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, fmt.Sprintf("%d", campaign.Id)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, swept.String()),
+		),
+	})
+	return ctx.EventManager().EmitTypedEvents(
+		&types.EventClaimRecordsCleared{Count: cleared, CampaignId: campaign.Id},
+		&types.EventCampaignEnded{CampaignId: campaign.Id, Swept: swept},
+	)
+}
+
+// EndCampaigns ends all campaigns whose decay is over
+func (k Keeper) EndCampaigns(ctx sdk.Context) error {
+	var over []types.Campaign
+	k.IterateActiveCampaigns(ctx, func(campaign types.Campaign) bool {
+		if !campaign.Ended && campaign.IsOver(ctx.BlockTime()) {
+			over = append(over, campaign)
+		}
+		return false
+	})
+	for _, campaign := range over {
+		if err := k.EndCampaign(ctx, campaign); err != nil {
+			return err
+		}
+	}
+	return nil
+}
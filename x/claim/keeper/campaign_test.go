@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/public-awesome/stargaze/x/claim"
+	"github.com/public-awesome/stargaze/x/claim/keeper"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+func (suite *KeeperTestSuite) TestCampaigns() {
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr1, nil, 0, 0))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr2, nil, 0, 0))
+	err := FundAccount(suite.app.BankKeeper, suite.ctx, creator, sdk.NewCoins(sdk.NewInt64Coin("uatom", 3000)))
+	suite.Require().NoError(err)
+
+	// the global airdrop has a record for addr1 as well
+	err = suite.app.ClaimKeeper.SetClaimRecord(suite.ctx, types.ClaimRecord{
+		Address:                addr1.String(),
+		InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+		ActionCompleted:        []bool{false, false, false, false, false},
+	})
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgCreateCampaign(
+		creator.String(), "atom stakers", "uatom", time.Time{},
+		time.Hour, time.Hour, types.NewLinearDecay(),
+		[]types.ActionWeight{
+			{Action: types.ActionInitialClaim, Weight: sdk.NewDecWithPrec(5, 1)},
+			{Action: types.ActionMintNFT, Weight: sdk.NewDecWithPrec(5, 1)},
+		},
+		[]types.ClaimRecord{
+			{Address: addr1.String(), InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000))},
+			{Address: addr2.String(), InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))},
+		},
+	)
+	msgServer := keeper.NewMsgServerImpl(suite.app.ClaimKeeper)
+
+	// creator is not allowed
+	_, err = msgServer.CreateCampaign(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedCampaignCreator)
+
+	params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+	params.CampaignCreators = []string{creator.String()}
+	suite.app.ClaimKeeper.SetParams(suite.ctx, params)
+
+	res, err := msgServer.CreateCampaign(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.CampaignId)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, creator).IsZero())
+
+	campaign, found := suite.app.ClaimKeeper.GetCampaign(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin("uatom", 3000), campaign.Balance)
+	suite.Require().Equal(suite.ctx.BlockTime(), campaign.StartTime)
+
+	// campaign funds are not part of the airdrop balance
+	suite.Require().Equal(sdk.NewInt64Coin(types.DefaultClaimDenom, 10000000), suite.app.ClaimKeeper.GetAirdropBalance(suite.ctx))
+
+	// initial claim pays both the airdrop and the campaign
+	claimed, err := msgServer.InitialClaim(sdk.WrapSDKContext(suite.ctx), types.NewMsgInitialClaim(addr1.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(
+		sdk.NewInt64Coin(types.DefaultClaimDenom, 200),
+		sdk.NewInt64Coin("uatom", 1000),
+	), claimed.ClaimedAmount)
+
+	record, err := suite.app.ClaimKeeper.GetCampaignClaimRecord(suite.ctx, 1, addr1)
+	suite.Require().NoError(err)
+	suite.Require().True(record.ActionCompleted[types.ActionInitialClaim])
+	campaign, _ = suite.app.ClaimKeeper.GetCampaign(suite.ctx, 1)
+	suite.Require().Equal(sdk.NewInt64Coin("uatom", 2000), campaign.Balance)
+
+	// actions outside the campaign weight table are not rewarded
+	coins, err := suite.app.ClaimKeeper.GetCampaignClaimableAmountForAction(suite.ctx, campaign, addr1, types.ActionBuySocialToken)
+	suite.Require().NoError(err)
+	suite.Require().True(coins.IsZero())
+
+	// half way through the decay
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour + 30*time.Minute))
+	coins, err = suite.app.ClaimKeeper.GetCampaignClaimableAmountForAction(ctx, campaign, addr2, types.ActionMintNFT)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 250)), coins)
+
+	// the campaign is swept to the community pool once over
+	ctx = suite.ctx.WithBlockTime(campaign.EndTime().Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	feePool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	claim.EndBlocker(ctx, suite.app.ClaimKeeper)
+
+	typedEvents := []proto.Message{}
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		typedEvents = append(typedEvents, msg)
+	}
+	suite.Require().Equal([]proto.Message{
+		&types.EventClaimRecordsCleared{Count: 2, CampaignId: 1},
+		&types.EventCampaignEnded{CampaignId: 1, Swept: sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000))},
+	}, typedEvents)
+
+	campaign, _ = suite.app.ClaimKeeper.GetCampaign(ctx, 1)
+	suite.Require().True(campaign.Ended)
+	suite.Require().True(campaign.Balance.IsZero())
+	suite.Require().Empty(suite.app.ClaimKeeper.CampaignClaimRecords(ctx, 1))
+	suite.Require().Equal(
+		feePool.Add(sdk.NewDecCoin("uatom", sdk.NewInt(2000))),
+		suite.app.DistrKeeper.GetFeePoolCommunityCoins(ctx),
+	)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, suite.app.ClaimKeeper.GetModuleAccountAddress(ctx), "uatom").IsZero())
+
+	// the campaign claims are still in the claim history after the records were cleared
+	history, err := suite.app.ClaimKeeper.ClaimHistory(sdk.WrapSDKContext(ctx), &types.QueryClaimHistoryRequest{Address: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(history.History, 2)
+	suite.Require().Equal(uint64(1), history.History[1].CampaignId)
+	suite.Require().Equal(types.ActionInitialClaim, history.History[1].Claim.Action)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), history.History[1].Claim.Amount)
+
+	// ended campaigns leave the active campaign index
+	visited := 0
+	suite.app.ClaimKeeper.IterateActiveCampaigns(ctx, func(types.Campaign) bool {
+		visited++
+		return false
+	})
+	suite.Require().Zero(visited)
+	suite.Require().Len(suite.app.ClaimKeeper.GetAllCampaigns(ctx), 1)
+
+	invariant, stop := keeper.AllInvariants(suite.app.ClaimKeeper)(ctx)
+	suite.Require().False(stop, invariant)
+}
+
+func (suite *KeeperTestSuite) TestCreateCampaignProposal() {
+	funder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 3000))
+	err := FundAccount(suite.app.BankKeeper, suite.ctx, funder, coins)
+	suite.Require().NoError(err)
+	err = suite.app.DistrKeeper.FundCommunityPool(suite.ctx, coins, funder)
+	suite.Require().NoError(err)
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+	handler := claim.NewClaimRecordsUpdateProposalHandler(suite.app.ClaimKeeper)
+	proposal := func(amount int64) *types.CreateCampaignProposal {
+		return types.NewCreateCampaignProposal(
+			"title", "description", "community campaign", types.DefaultClaimDenom, time.Time{},
+			time.Hour, time.Hour, types.NewLinearDecay(), nil,
+			[]types.ClaimRecord{{Address: addr1.String(), InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, amount))}},
+		)
+	}
+
+	// the community pool must cover the campaign
+	ctx, _ := suite.ctx.CacheContext()
+	err = handler(ctx, proposal(communityPool.AmountOf(types.DefaultClaimDenom).TruncateInt64()+1))
+	suite.Require().Error(err)
+
+	// the campaign is funded from the community pool without an allowlisted creator
+	moduleBalance := suite.app.ClaimKeeper.GetModuleAccountBalance(suite.ctx)
+	err = handler(suite.ctx, proposal(2000))
+	suite.Require().NoError(err)
+	campaign, found := suite.app.ClaimKeeper.GetCampaign(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin(types.DefaultClaimDenom, 2000), campaign.Balance)
+	suite.Require().Equal(suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String(), campaign.Funder)
+	suite.Require().Equal(moduleBalance.AddAmount(sdk.NewInt(2000)), suite.app.ClaimKeeper.GetModuleAccountBalance(suite.ctx))
+	suite.Require().Equal(communityPool.Sub(sdk.NewDecCoins(sdk.NewInt64DecCoin(types.DefaultClaimDenom, 2000))), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+
+	record, err := suite.app.ClaimKeeper.GetCampaignClaimRecord(suite.ctx, 1, addr1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr1.String(), record.Address)
+}
@@ -1,19 +1,41 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/public-awesome/stargaze/x/claim/types"
 )
 
-// CreateModuleAccount creates module account of airdrop module
+// CreateModuleAccount creates module account of airdrop module and mints the part of the airdrop
+// balance it doesn't hold yet, an imported module account already holds it through the bank genesis.
+// It panics if the mint would exceed the mint module max supply.
 func (k Keeper) CreateModuleAccount(ctx sdk.Context, amount sdk.Coin) {
-	moduleAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter)
-	k.accountKeeper.SetModuleAccount(ctx, moduleAcc)
-	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
-	if err != nil {
+	moduleAccAddr := k.GetModuleAccountAddress(ctx)
+	if k.accountKeeper.GetAccount(ctx, moduleAccAddr) == nil {
+		moduleAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter, authtypes.Burner)
+		k.accountKeeper.SetModuleAccount(ctx, k.accountKeeper.NewAccount(ctx, moduleAcc).(authtypes.ModuleAccountI))
+	}
+
+	held := k.bankKeeper.GetBalance(ctx, moduleAccAddr, amount.Denom).Amount
+	held = held.Sub(k.CampaignBalances(ctx).Add(k.VestingEscrowBalances(ctx)...).AmountOf(amount.Denom))
+	if held.IsNegative() {
+		held = sdk.ZeroInt()
+	}
+	if amount.Amount.LTE(held) {
+		return
+	}
+	minted := sdk.NewCoins(sdk.NewCoin(amount.Denom, amount.Amount.Sub(held)))
+	if err := k.mintKeeper.ValidateExternalMint(ctx, minted); err != nil {
+		panic(err)
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, minted); err != nil {
 		panic(err)
 	}
 }
@@ -29,17 +51,30 @@ func (k Keeper) SetClaimRecords(ctx sdk.Context, claimRecords []types.ClaimRecor
 	return nil
 }
 
-// SetClaimRecord sets a claim record for an address in store
+// SetClaimRecord sets a claim record for an address in store and updates the airdrop totals
 func (k Keeper) SetClaimRecord(ctx sdk.Context, claimRecord types.ClaimRecord) error {
-	store := ctx.KVStore(k.storeKey)
-	prefixStore := prefix.NewStore(store, types.ClaimRecordsStorePrefix)
-
-	bz, err := proto.Marshal(&claimRecord)
+	addr, err := sdk.AccAddressFromBech32(claimRecord.Address)
+	if err != nil {
+		return err
+	}
+	previous, err := k.GetClaimRecord(ctx, addr)
 	if err != nil {
 		return err
 	}
+	claimRecord.FullyClaimed = isFullyClaimed(k.GetParams(ctx).EffectiveActionWeights(), claimRecord)
+	if err := k.setClaimRecord(ctx, addr, claimRecord); err != nil {
+		return err
+	}
+	k.updateAirdropTotals(ctx, previous, claimRecord)
+	return nil
+}
 
-	addr, err := sdk.AccAddressFromBech32(claimRecord.Address)
+// setClaimRecord stores a claim record without updating the airdrop totals
+func (k Keeper) setClaimRecord(ctx sdk.Context, addr sdk.AccAddress, claimRecord types.ClaimRecord) error {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.ClaimRecordsStorePrefix)
+
+	bz, err := proto.Marshal(&claimRecord)
 	if err != nil {
 		return err
 	}
@@ -48,26 +83,141 @@ func (k Keeper) SetClaimRecord(ctx sdk.Context, claimRecord types.ClaimRecord) e
 	return nil
 }
 
-// ClaimRecords get claimables for genesis export
-func (k Keeper) ClaimRecords(ctx sdk.Context) []types.ClaimRecord {
+// DeleteClaimRecord removes the claim record of an address and updates the airdrop totals
+func (k Keeper) DeleteClaimRecord(ctx sdk.Context, addr sdk.AccAddress) error {
+	previous, err := k.GetClaimRecord(ctx, addr)
+	if err != nil {
+		return err
+	}
 	store := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(store, types.ClaimRecordsStorePrefix)
+	prefixStore.Delete(addr)
+	k.updateAirdropTotals(ctx, previous, types.ClaimRecord{})
+	return nil
+}
 
-	iterator := prefixStore.Iterator(nil, nil)
-	defer iterator.Close()
-
-	claimRecords := []types.ClaimRecord{}
-	for ; iterator.Valid(); iterator.Next() {
+// updateAirdropTotals keeps the allocated amount and fully claimed address count of the airdrop
+// state in sync with a claim record change, so the airdrop stats don't iterate over the records.
+// The count follows the stored fully claimed flags, which don't change with the action weights.
+func (k Keeper) updateAirdropTotals(ctx sdk.Context, previous, current types.ClaimRecord) {
+	state := k.GetAirdropState(ctx)
+	state.TotalAllocated = state.TotalAllocated.Add(current.InitialClaimableAmount...).Sub(previous.InitialClaimableAmount)
+	if previous.FullyClaimed && state.FullyClaimedAddresses > 0 {
+		state.FullyClaimedAddresses--
+	}
+	if current.FullyClaimed {
+		state.FullyClaimedAddresses++
+	}
+	k.SetAirdropState(ctx, state)
+}
 
-		claimRecord := types.ClaimRecord{}
+// TransferClaimRecord moves the claim record of an address to a new address without a claim record,
+// together with its campaign claim records which still have something to claim. Completed actions
+// stay completed so the records cannot be claimed twice.
+func (k Keeper) TransferClaimRecord(ctx sdk.Context, from, to sdk.AccAddress) error {
+	params := k.GetParams(ctx)
+	claimRecord, err := k.GetClaimRecord(ctx, from)
+	if err != nil {
+		return err
+	}
+	if claimRecord.Address != "" {
+		existing, err := k.GetClaimRecord(ctx, to)
+		if err != nil {
+			return err
+		}
+		if existing.Address != "" {
+			return sdkerrors.Wrapf(types.ErrClaimRecordExists, "%s", to)
+		}
+		if isFullyClaimed(params.EffectiveActionWeights(), claimRecord) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "claim record of %s is fully claimed", from)
+		}
+		if params.DisableTransferAfterClaim && hasClaimed(claimRecord) {
+			return sdkerrors.Wrapf(types.ErrClaimRecordTransferDisabled, "%s", from)
+		}
+	}
 
-		err := proto.Unmarshal(iterator.Value(), &claimRecord)
+	// all campaign records are checked before anything moves
+	campaignRecords := make(map[uint64]types.ClaimRecord)
+	var campaignIDs []uint64
+	var iterErr error
+	k.IterateActiveCampaigns(ctx, func(campaign types.Campaign) bool {
+		record, err := k.GetCampaignClaimRecord(ctx, campaign.Id, from)
 		if err != nil {
-			panic(err)
+			iterErr = err
+			return true
+		}
+		if record.Address == "" || isFullyClaimed(campaign.EffectiveActionWeights(), record) {
+			return false
 		}
+		existing, err := k.GetCampaignClaimRecord(ctx, campaign.Id, to)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		if existing.Address != "" {
+			iterErr = sdkerrors.Wrapf(types.ErrClaimRecordExists, "%s in campaign %d", to, campaign.Id)
+			return true
+		}
+		if params.DisableTransferAfterClaim && hasClaimed(record) {
+			iterErr = sdkerrors.Wrapf(types.ErrClaimRecordTransferDisabled, "%s in campaign %d", from, campaign.Id)
+			return true
+		}
+		campaignRecords[campaign.Id] = record
+		campaignIDs = append(campaignIDs, campaign.Id)
+		return false
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	if claimRecord.Address == "" && len(campaignIDs) == 0 {
+		return sdkerrors.Wrapf(types.ErrClaimRecordNotFound, "%s", from)
+	}
 
-		claimRecords = append(claimRecords, claimRecord)
+	if claimRecord.Address != "" {
+		claimRecord.Address = to.String()
+		if err := k.SetClaimRecord(ctx, claimRecord); err != nil {
+			return err
+		}
+		if err := k.DeleteClaimRecord(ctx, from); err != nil {
+			return err
+		}
+	}
+	for _, id := range campaignIDs {
+		record := campaignRecords[id]
+		record.Address = to.String()
+		if err := k.SetCampaignClaimRecord(ctx, id, record); err != nil {
+			return err
+		}
+		k.DeleteCampaignClaimRecord(ctx, id, from)
 	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferClaimRecord,
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, to.String()),
+		),
+	})
+	return nil
+}
+
+// hasClaimed returns true if any action of the claim record was completed
+func hasClaimed(claimRecord types.ClaimRecord) bool {
+	for _, completed := range claimRecord.ActionCompleted {
+		if completed {
+			return true
+		}
+	}
+	return false
+}
+
+// GetClaimRecords get claimables for genesis export
+func (k Keeper) GetClaimRecords(ctx sdk.Context) []types.ClaimRecord {
+	claimRecords := []types.ClaimRecord{}
+	k.IterateClaimRecords(ctx, func(claimRecord types.ClaimRecord) bool {
+		claimRecords = append(claimRecords, claimRecord)
+		return false
+	})
 	return claimRecords
 }
 
@@ -110,41 +260,37 @@ func (k Keeper) GetClaimableAmountForAction(ctx sdk.Context, addr sdk.AccAddress
 	// If we are before the start time, do nothing.
 	// This case _shouldn't_ occur on chain, since the
 	// start time ought to be chain start time.
-	if ctx.BlockTime().Before(params.AirdropStartTime) {
+	if params.IsAirdropStartPending() || ctx.BlockTime().Before(params.AirdropStartTime) {
 		return sdk.Coins{}, nil
 	}
 
-	InitialClaimablePerAction := sdk.Coins{}
-	for _, coin := range claimRecord.InitialClaimableAmount {
-		InitialClaimablePerAction = InitialClaimablePerAction.Add(
-			sdk.NewCoin(coin.Denom,
-				coin.Amount.QuoRaw(int64(len(types.Action_name))),
-			),
-		)
-	}
+	InitialClaimablePerAction := initialClaimableForAction(params.EffectiveActionWeights(), claimRecord, action)
 
 	elapsedAirdropTime := ctx.BlockTime().Sub(params.AirdropStartTime)
+	return decayClaimable(InitialClaimablePerAction, elapsedAirdropTime, params.DurationUntilDecay, params.DurationOfDecay, params.DecayFunction), nil
+}
+
+// decayClaimable applies the decay schedule to an initial claimable amount
+func decayClaimable(initial sdk.Coins, elapsed, durationUntilDecay, durationOfDecay time.Duration, decay types.DecayFunction) sdk.Coins {
 	// Are we early enough in the airdrop s.t. theres no decay?
-	if elapsedAirdropTime <= params.DurationUntilDecay {
-		return InitialClaimablePerAction, nil
+	if elapsed <= durationUntilDecay {
+		return initial
 	}
 
 	// The entire airdrop has completed
-	if elapsedAirdropTime > params.DurationUntilDecay+params.DurationOfDecay {
-		return sdk.Coins{}, nil
+	if elapsed > durationUntilDecay+durationOfDecay {
+		return sdk.Coins{}
 	}
 
-	// Positive, since goneTime > params.DurationUntilDecay
-	decayTime := elapsedAirdropTime - params.DurationUntilDecay
-	decayPercent := sdk.NewDec(decayTime.Nanoseconds()).QuoInt64(params.DurationOfDecay.Nanoseconds())
-	claimablePercent := sdk.OneDec().Sub(decayPercent)
+	// Positive, since goneTime > durationUntilDecay
+	decayTime := elapsed - durationUntilDecay
+	claimablePercent := decay.ClaimablePercent(decayTime, durationOfDecay)
 
 	claimableCoins := sdk.Coins{}
-	for _, coin := range InitialClaimablePerAction {
+	for _, coin := range initial {
 		claimableCoins = claimableCoins.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(claimablePercent).RoundInt()))
 	}
-
-	return claimableCoins, nil
+	return claimableCoins
 }
 
 // GetClaimable returns claimable amount for a specific action done by an address
@@ -169,8 +315,24 @@ func (k Keeper) GetUserTotalClaimable(ctx sdk.Context, addr sdk.AccAddress) (sdk
 	return totalClaimable, nil
 }
 
-// ClaimCoins remove claimable amount entry and transfer it to user's account
+// ClaimCoinsForAction claims the airdrop and all active campaigns for an action
 func (k Keeper) ClaimCoinsForAction(ctx sdk.Context, addr sdk.AccAddress, action types.Action) (sdk.Coins, error) {
+	claimed, err := k.claimAirdropForAction(ctx, addr, action)
+	if err != nil {
+		return nil, err
+	}
+	campaignsClaimed, err := k.claimCampaignsForAction(ctx, addr, action)
+	if err != nil {
+		return nil, err
+	}
+	if campaignsClaimed.Empty() {
+		return claimed, nil
+	}
+	return claimed.Add(campaignsClaimed...), nil
+}
+
+// claimAirdropForAction removes the claimable amount of the global airdrop and transfers it to user's account
+func (k Keeper) claimAirdropForAction(ctx sdk.Context, addr sdk.AccAddress, action types.Action) (sdk.Coins, error) {
 	params := k.GetParams(ctx)
 	if !params.IsAirdropEnabled(ctx.BlockTime()) {
 		return sdk.Coins{}, nil
@@ -189,52 +351,226 @@ func (k Keeper) ClaimCoinsForAction(ctx sdk.Context, addr sdk.AccAddress, action
 		return nil, err
 	}
 
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, claimableAmount)
+	err = k.sendClaimedCoins(ctx, addr, claimableAmount)
 	if err != nil {
 		return nil, err
 	}
 
 	claimRecord.ActionCompleted[action] = true
-
 	err = k.SetClaimRecord(ctx, claimRecord)
 	if err != nil {
 		return claimableAmount, err
 	}
+	err = k.recordClaim(ctx, 0, addr, action, claimableAmount)
+	if err != nil {
+		return claimableAmount, err
+	}
+
+	state := k.GetAirdropState(ctx)
+	state.AddClaimed(action, claimableAmount)
+	k.SetAirdropState(ctx, state)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeClaim,
 			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, action.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, claimableAmount.String()),
 		),
 	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventClaim{
+		Address: addr.String(),
+		Action:  action,
+		Amount:  claimableAmount,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return claimableAmount, nil
 }
 
-// FundRemainingsToCommunity fund remainings to the community when airdrop period end
-func (k Keeper) fundRemainingsToCommunity(ctx sdk.Context) error {
+// initialClaimableForAction returns the share of the initial claimable amount for an action.
+// The rounding remainder goes to the last action of the weight table.
+func initialClaimableForAction(weights []types.ActionWeight, claimRecord types.ClaimRecord, action types.Action) sdk.Coins {
+	claimable := sdk.Coins{}
+	for _, coin := range claimRecord.InitialClaimableAmount {
+		remaining := coin.Amount
+		for i, w := range weights {
+			share := coin.Amount.ToDec().Mul(w.Weight).TruncateInt()
+			if i == len(weights)-1 {
+				share = remaining
+			}
+			if w.Action == action {
+				claimable = claimable.Add(sdk.NewCoin(coin.Denom, share))
+				break
+			}
+			remaining = remaining.Sub(share)
+		}
+	}
+	return claimable
+}
+
+// fundRemainingsToCommunity fund remainings to the community when airdrop period end
+func (k Keeper) fundRemainingsToCommunity(ctx sdk.Context) (sdk.Coins, error) {
 	moduleAccAddr := k.GetModuleAccountAddress(ctx)
-	amt := k.GetModuleAccountBalance(ctx)
-	return k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(amt), moduleAccAddr)
+	amt := sdk.NewCoins(k.GetAirdropBalance(ctx))
+	return amt, k.distrKeeper.FundCommunityPool(ctx, amt, moduleAccAddr)
 }
 
+// GetAirdropBalance returns the module account balance of the airdrop, excluding the funds of campaigns
+// and vesting escrows
+func (k Keeper) GetAirdropBalance(ctx sdk.Context) sdk.Coin {
+	balance := k.GetModuleAccountBalance(ctx)
+	campaignFunds := k.CampaignBalances(ctx).Add(k.VestingEscrowBalances(ctx)...).AmountOf(balance.Denom)
+	if campaignFunds.GT(balance.Amount) {
+		return sdk.NewCoin(balance.Denom, sdk.ZeroInt())
+	}
+	return balance.Sub(sdk.NewCoin(balance.Denom, campaignFunds))
+}
+
+// StartAirdrop records the block time as the airdrop start time once the airdrop start height is reached,
+// so decay is measured from the actual start. It is a no-op if the start height isn't reached.
+func (k Keeper) StartAirdrop(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if !params.AirdropEnabled || !params.IsAirdropStartHeightReached(ctx.BlockHeight()) {
+		return nil
+	}
+	params.AirdropStartTime = ctx.BlockTime()
+	params.AirdropStartHeight = 0
+	k.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAirdropStarted,
+			sdk.NewAttribute(types.AttributeKeyStartTime, ctx.BlockTime().String()),
+		),
+	})
+	return ctx.EventManager().EmitTypedEvent(&types.EventAirdropStarted{
+		Height:    ctx.BlockHeight(),
+		StartTime: ctx.BlockTime(),
+	})
+}
+
+// EndAirdrop sweeps the unclaimed balance to the community pool, clears the claim records
+// and marks the airdrop as ended. It is a no-op if the airdrop already ended.
 func (k Keeper) EndAirdrop(ctx sdk.Context) error {
-	err := k.fundRemainingsToCommunity(ctx)
+	state := k.GetAirdropState(ctx)
+	if state.Stage == types.AirdropStageEnded {
+		return nil
+	}
+	swept, err := k.fundRemainingsToCommunity(ctx)
 	if err != nil {
 		return err
 	}
-	k.clearInitialClaimables(ctx)
-	return nil
+	cleared := k.clearInitialClaimables(ctx)
+
+	// nothing is allocated anymore once the claim records are cleared
+	state.TotalAllocated = sdk.Coins{}
+	state.FullyClaimedAddresses = 0
+	state.Stage = types.AirdropStageEnded
+	state.EndHeight = ctx.BlockHeight()
+	state.SweptAmount = swept
+	k.SetAirdropState(ctx, state)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAirdropEnded,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, swept.String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	})
+	return ctx.EventManager().EmitTypedEvents(
+		&types.EventClaimRecordsCleared{Count: cleared},
+		&types.EventAirdropEnded{Swept: swept},
+	)
 }
 
-// ClearClaimables clear claimable amounts
-func (k Keeper) clearInitialClaimables(ctx sdk.Context) {
+// GetAirdropState returns the airdrop lifecycle state
+func (k Keeper) GetAirdropState(ctx sdk.Context) types.AirdropState {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AirdropStateKey)
+	if bz == nil {
+		return types.AirdropState{}
+	}
+	var state types.AirdropState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state
+}
+
+// SetAirdropState sets the airdrop lifecycle state
+func (k Keeper) SetAirdropState(ctx sdk.Context, state types.AirdropState) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AirdropStateKey, k.cdc.MustMarshal(&state))
+}
+
+// ClearClaimables clear claimable amounts and returns the number of cleared claim records
+func (k Keeper) clearInitialClaimables(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.ClaimRecordsStorePrefix)
 	defer iterator.Close()
+	count := uint64(0)
 	for ; iterator.Valid(); iterator.Next() {
 		key := iterator.Key()
 		store.Delete(key)
+		count++
+	}
+	return count
+}
+
+// IterateClaimRecords iterates over all claim records, stopping when the callback returns true
+func (k Keeper) IterateClaimRecords(ctx sdk.Context, cb func(claimRecord types.ClaimRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.ClaimRecordsStorePrefix)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		claimRecord := types.ClaimRecord{}
+		err := proto.Unmarshal(iterator.Value(), &claimRecord)
+		if err != nil {
+			panic(err)
+		}
+		if cb(claimRecord) {
+			break
+		}
+	}
+}
+
+// GetAirdropStats returns the aggregate progress of the airdrop from the totals kept in the airdrop state
+func (k Keeper) GetAirdropStats(ctx sdk.Context) types.QueryAirdropStatsResponse {
+	state := k.GetAirdropState(ctx)
+
+	stats := types.QueryAirdropStatsResponse{
+		TotalAllocated:        state.TotalAllocated,
+		FullyClaimedAddresses: state.FullyClaimedAddresses,
+		RemainingBalance:      sdk.NewCoins(k.GetAirdropBalance(ctx)),
+	}
+	if stats.TotalAllocated == nil {
+		stats.TotalAllocated = sdk.Coins{}
+	}
+	for action := range types.Action_name {
+		stats.Claimed = append(stats.Claimed, types.ActionClaimed{
+			Action: types.Action(action),
+			Amount: state.ClaimedForAction(types.Action(action)),
+		})
+	}
+	sort.Slice(stats.Claimed, func(i, j int) bool {
+		return stats.Claimed[i].Action < stats.Claimed[j].Action
+	})
+	return stats
+}
+
+// isFullyClaimed returns true if every action with a positive weight has been completed
+func isFullyClaimed(weights []types.ActionWeight, claimRecord types.ClaimRecord) bool {
+	for _, w := range weights {
+		if !w.Weight.IsPositive() {
+			continue
+		}
+		if int(w.Action) >= len(claimRecord.ActionCompleted) || !claimRecord.ActionCompleted[w.Action] {
+			return false
+		}
 	}
+	return true
 }
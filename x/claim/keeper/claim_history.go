@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// SetClaimHistoryRecord stores a claim of an address. The claim history is never cleared or
+// transferred, so claims can be looked up after the airdrop or their campaign ended.
+func (k Keeper) SetClaimHistoryRecord(ctx sdk.Context, record types.ClaimHistoryRecord) error {
+	addr, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClaimHistoryKey(addr, record.CampaignId, record.Claim.Action), k.cdc.MustMarshal(&record))
+	return nil
+}
+
+// recordClaim adds the amount received at the current block for an action of a campaign to the
+// claim history, campaign 0 being the airdrop
+func (k Keeper) recordClaim(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress, action types.Action, amount sdk.Coins) error {
+	return k.SetClaimHistoryRecord(ctx, types.ClaimHistoryRecord{
+		Address:    addr.String(),
+		CampaignId: campaignID,
+		Claim: types.ActionClaim{
+			Action: action,
+			Amount: amount,
+			Height: ctx.BlockHeight(),
+			Time:   ctx.BlockTime(),
+		},
+	})
+}
+
+// GetClaimHistory returns the claims of an address ordered by campaign and action
+func (k Keeper) GetClaimHistory(ctx sdk.Context, addr sdk.AccAddress) []types.ClaimHistoryRecord {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.ClaimHistoryPrefix(addr))
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.ClaimHistoryRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ClaimHistoryRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetAllClaimHistory returns the claims of all addresses for genesis export
+func (k Keeper) GetAllClaimHistory(ctx sdk.Context) []types.ClaimHistoryRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimHistoryStorePrefix)
+	defer iterator.Close()
+
+	records := []types.ClaimHistoryRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ClaimHistoryRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
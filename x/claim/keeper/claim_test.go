@@ -5,9 +5,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/public-awesome/stargaze/x/claim"
+	"github.com/public-awesome/stargaze/x/claim/keeper"
 	"github.com/public-awesome/stargaze/x/claim/types"
 )
 
@@ -205,6 +209,323 @@ func (suite *KeeperTestSuite) TestDuplicatedActionNotWithdrawRepeatedly() {
 	suite.Require().Equal(claimedCoins.AmountOf(types.DefaultClaimDenom), claimRecords[0].InitialClaimableAmount.AmountOf(types.DefaultClaimDenom).Quo(sdk.NewInt(5)))
 }
 
+func (suite *KeeperTestSuite) TestClaimHooks() {
+	for _, tc := range []struct {
+		name   string
+		action types.Action
+		hook   func(ctx sdk.Context, addr sdk.AccAddress)
+	}{
+		{"mint nft", types.ActionMintNFT, suite.app.ClaimKeeper.Hooks().AfterMintNFT},
+		{"buy social token", types.ActionBuySocialToken, suite.app.ClaimKeeper.Hooks().AfterBuySocialToken},
+	} {
+		suite.Run(tc.name, func() {
+			pub1 := secp256k1.GenPrivKey().PubKey()
+			addr1 := sdk.AccAddress(pub1.Address())
+
+			claimRecords := []types.ClaimRecord{
+				{
+					Address:                addr1.String(),
+					InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 2000)),
+					ActionCompleted:        []bool{false, false, false, false, false},
+				},
+			}
+			suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr1, nil, 0, 0))
+
+			err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, claimRecords)
+			suite.Require().NoError(err)
+
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			tc.hook(ctx, addr1)
+			claim, err := suite.app.ClaimKeeper.GetClaimRecord(ctx, addr1)
+			suite.Require().NoError(err)
+			suite.Require().True(claim.ActionCompleted[tc.action])
+
+			expected := claimRecords[0].InitialClaimableAmount.AmountOf(types.DefaultClaimDenom).Quo(sdk.NewInt(5))
+			claimedCoins := suite.app.BankKeeper.GetAllBalances(ctx, addr1)
+			suite.Require().Equal(expected, claimedCoins.AmountOf(types.DefaultClaimDenom))
+
+			found := false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeClaim {
+					continue
+				}
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == types.AttributeKeyAction {
+						suite.Require().Equal(tc.action.String(), string(attr.Value))
+						found = true
+					}
+				}
+			}
+			suite.Require().True(found)
+
+			// completing the same action again does not withdraw twice
+			tc.hook(ctx, addr1)
+			claimedCoins = suite.app.BankKeeper.GetAllBalances(ctx, addr1)
+			suite.Require().Equal(expected, claimedCoins.AmountOf(types.DefaultClaimDenom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestActionWeights() {
+	params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+	params.ActionWeights = []types.ActionWeight{
+		{Action: types.ActionInitialClaim, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Action: types.ActionMintNFT, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Action: types.ActionDelegateStake, Weight: sdk.NewDecWithPrec(4, 1)},
+	}
+	suite.app.ClaimKeeper.SetParams(suite.ctx, params)
+
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	claimRecords := []types.ClaimRecord{
+		{
+			Address:                addr1.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1001)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+	}
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, claimRecords)
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		action types.Action
+		amount int64
+	}{
+		{types.ActionInitialClaim, 300},
+		{types.ActionBuySocialToken, 0},
+		{types.ActionMintNFT, 300},
+		{types.ActionVote, 0},
+		// rounding remainder goes to the last action of the table
+		{types.ActionDelegateStake, 401},
+	} {
+		coins, err := suite.app.ClaimKeeper.GetClaimableAmountForAction(suite.ctx, addr1, tc.action)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewInt(tc.amount), coins.AmountOf(types.DefaultClaimDenom), tc.action.String())
+	}
+
+	total, err := suite.app.ClaimKeeper.GetUserTotalClaimable(suite.ctx, addr1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(claimRecords[0].InitialClaimableAmount, total)
+}
+
+func (suite *KeeperTestSuite) TestClaimableSchedule() {
+	params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+	params.DurationUntilDecay = time.Hour
+	params.DurationOfDecay = time.Hour * 4
+	params.DecayFunction = types.NewStepDecay(2)
+	suite.app.ClaimKeeper.SetParams(suite.ctx, params)
+	ctx := suite.ctx.WithBlockTime(params.AirdropStartTime)
+
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err := suite.app.ClaimKeeper.SetClaimRecords(ctx, []types.ClaimRecord{
+		{
+			Address:                addr1.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.app.ClaimKeeper.ClaimableSchedule(sdk.WrapSDKContext(ctx), &types.QueryClaimableScheduleRequest{
+		Address:  addr1.String(),
+		Interval: time.Hour,
+		Count:    6,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Schedule, 6)
+	for i, expected := range []int64{1000, 1000, 1000, 500, 500, 0} {
+		suite.Require().Equal(params.AirdropStartTime.Add(time.Hour*time.Duration(i)), res.Schedule[i].Time)
+		suite.Require().Equal(sdk.NewInt(expected), res.Schedule[i].Coins.AmountOf(types.DefaultClaimDenom), "projection %d", i)
+	}
+
+	_, err = suite.app.ClaimKeeper.ClaimableSchedule(sdk.WrapSDKContext(ctx), &types.QueryClaimableScheduleRequest{
+		Address:  addr1.String(),
+		Interval: time.Hour,
+		Count:    types.MaxClaimableScheduleCount + 1,
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestClaimRecordsQuery() {
+	claimRecords := []types.ClaimRecord{}
+	for i := 0; i < 5; i++ {
+		claimRecords = append(claimRecords, types.ClaimRecord{
+			Address:                sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		})
+	}
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, claimRecords)
+	suite.Require().NoError(err)
+
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	res, err := suite.app.ClaimKeeper.ClaimRecords(goCtx, &types.QueryClaimRecordsRequest{
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ClaimRecords, 3)
+	suite.Require().Equal(uint64(5), res.Pagination.Total)
+
+	res, err = suite.app.ClaimKeeper.ClaimRecords(goCtx, &types.QueryClaimRecordsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ClaimRecords, 2)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
+func (suite *KeeperTestSuite) TestAirdropStats() {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, []types.ClaimRecord{
+		{
+			Address:                addr1.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+		{
+			Address:                addr2.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 2000)),
+			ActionCompleted:        []bool{false, true, true, true, true},
+		},
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ActionInitialClaim)
+	suite.Require().NoError(err)
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr2, types.ActionInitialClaim)
+	suite.Require().NoError(err)
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ActionMintNFT)
+	suite.Require().NoError(err)
+
+	res, err := suite.app.ClaimKeeper.AirdropStats(sdk.WrapSDKContext(suite.ctx), &types.QueryAirdropStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 3000)), res.TotalAllocated)
+	suite.Require().Equal(uint64(1), res.FullyClaimedAddresses)
+	suite.Require().Len(res.Claimed, len(types.Action_name))
+	suite.Require().Equal(types.ActionInitialClaim, res.Claimed[0].Action)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 600)), res.Claimed[0].Amount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 200)), res.Claimed[types.ActionMintNFT].Amount)
+	suite.Require().True(res.Claimed[types.ActionVote].Amount.IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 10000000-800)), res.RemainingBalance)
+
+	// transfers keep the totals
+	addr3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = suite.app.ClaimKeeper.TransferClaimRecord(suite.ctx, addr1, addr3)
+	suite.Require().NoError(err)
+	stats := suite.app.ClaimKeeper.GetAirdropStats(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 3000)), stats.TotalAllocated)
+	suite.Require().Equal(uint64(1), stats.FullyClaimedAddresses)
+
+	// updating and revoking records through governance adjusts the totals
+	proposal := types.NewClaimRecordsUpdateProposal("title", "description", []types.ClaimRecord{
+		{Address: addr3.String(), InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1500))},
+	}, []string{addr2.String()})
+	err = keeper.HandleClaimRecordsUpdateProposal(suite.ctx, suite.app.ClaimKeeper, proposal)
+	suite.Require().NoError(err)
+	stats = suite.app.ClaimKeeper.GetAirdropStats(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1500)), stats.TotalAllocated)
+	suite.Require().Zero(stats.FullyClaimedAddresses)
+}
+
+func (suite *KeeperTestSuite) TestAirdropStatsActionWeightsChange() {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, []types.ClaimRecord{
+		{
+			Address:                addr1.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{true, true, true, true, true},
+		},
+		{
+			Address:                addr2.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), suite.app.ClaimKeeper.GetAirdropStats(suite.ctx).FullyClaimedAddresses)
+
+	setWeights := func(weights []types.ActionWeight) {
+		params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+		params.ActionWeights = weights
+		suite.app.ClaimKeeper.SetParams(suite.ctx, params)
+	}
+
+	// only the initial claim is rewarded mid-airdrop, which fully claims addr2
+	setWeights([]types.ActionWeight{{Action: types.ActionInitialClaim, Weight: sdk.OneDec()}})
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr2, types.ActionInitialClaim)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), suite.app.ClaimKeeper.GetAirdropStats(suite.ctx).FullyClaimedAddresses)
+
+	// back to rewarding all actions, updated records are counted by their new state
+	setWeights(nil)
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr2, types.ActionMintNFT)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), suite.app.ClaimKeeper.GetAirdropStats(suite.ctx).FullyClaimedAddresses)
+	proposal := types.NewClaimRecordsUpdateProposal("title", "description", nil, []string{addr1.String()})
+	err = keeper.HandleClaimRecordsUpdateProposal(suite.ctx, suite.app.ClaimKeeper, proposal)
+	suite.Require().NoError(err)
+	suite.Require().Zero(suite.app.ClaimKeeper.GetAirdropStats(suite.ctx).FullyClaimedAddresses)
+}
+
+func (suite *KeeperTestSuite) TestClaimHistory() {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, []types.ClaimRecord{
+		{
+			Address:                addr1.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ActionInitialClaim)
+	suite.Require().NoError(err)
+
+	// claim during the decay at a later block
+	params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 10).
+		WithBlockTime(params.AirdropStartTime.Add(params.DurationUntilDecay + params.DurationOfDecay/2))
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(ctx, addr1, types.ActionMintNFT)
+	suite.Require().NoError(err)
+
+	claims := []types.ActionClaim{
+		{
+			Action: types.ActionInitialClaim,
+			Amount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 200)),
+			Height: suite.ctx.BlockHeight(),
+			Time:   suite.ctx.BlockTime(),
+		},
+		{
+			Action: types.ActionMintNFT,
+			Amount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 100)),
+			Height: ctx.BlockHeight(),
+			Time:   ctx.BlockTime(),
+		},
+	}
+	suite.Require().Equal([]types.ClaimHistoryRecord{
+		{Address: addr1.String(), Claim: claims[0]},
+		{Address: addr1.String(), Claim: claims[1]},
+	}, suite.app.ClaimKeeper.GetClaimHistory(ctx, addr1))
+
+	res, err := suite.app.ClaimKeeper.ClaimHistory(sdk.WrapSDKContext(ctx), &types.QueryClaimHistoryRequest{Address: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.History, 2)
+	suite.Require().Equal(uint64(0), res.History[0].CampaignId)
+	suite.Require().Equal(claims[1], res.History[1].Claim)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 300)), res.TotalClaimed)
+
+	// the history survives the end of the airdrop which clears the claim records
+	err = suite.app.ClaimKeeper.EndAirdrop(ctx)
+	suite.Require().NoError(err)
+	record, err := suite.app.ClaimKeeper.GetClaimRecord(ctx, addr1)
+	suite.Require().NoError(err)
+	suite.Require().Empty(record.Address)
+	ended, err := suite.app.ClaimKeeper.ClaimHistory(sdk.WrapSDKContext(ctx), &types.QueryClaimHistoryRequest{Address: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res, ended)
+}
+
 func (suite *KeeperTestSuite) TestNotRunningGenesisBlock() {
 	suite.ctx = suite.ctx.WithBlockHeight(1)
 	suite.app.ClaimKeeper.CreateModuleAccount(suite.ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000000)))
@@ -367,6 +688,155 @@ func (suite *KeeperTestSuite) TestEndAirdrop() {
 	moduleAccAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
 	coins := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAccAddr, types.DefaultClaimDenom)
 	suite.Require().Equal(sdk.NewInt64Coin(types.DefaultClaimDenom, 0).String(), coins.String())
+
+	state := suite.app.ClaimKeeper.GetAirdropState(suite.ctx)
+	suite.Require().Equal(types.AirdropStageEnded, state.Stage)
+	suite.Require().Equal(suite.ctx.BlockHeight(), state.EndHeight)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 10000000)), state.SweptAmount)
+
+	// ending the airdrop again is a no-op
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	err = suite.app.ClaimKeeper.EndAirdrop(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(ctx.EventManager().Events())
+	suite.Require().Equal(state, suite.app.ClaimKeeper.GetAirdropState(ctx))
+}
+
+func (suite *KeeperTestSuite) TestTypedEvents() {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr1, nil, 0, 0))
+
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, []types.ClaimRecord{
+		{
+			Address:                addr1.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+		{
+			Address:                addr2.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+	})
+	suite.Require().NoError(err)
+
+	typedEvents := func(ctx sdk.Context) []proto.Message {
+		msgs := []proto.Message{}
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			msg, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+			msgs = append(msgs, msg)
+		}
+		return msgs
+	}
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(ctx, addr1, types.ActionInitialClaim)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proto.Message{&types.EventClaim{
+		Address: addr1.String(),
+		Action:  types.ActionInitialClaim,
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 200)),
+	}}, typedEvents(ctx))
+
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.app.ClaimKeeper.EndAirdrop(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proto.Message{
+		&types.EventClaimRecordsCleared{Count: 2},
+		&types.EventAirdropEnded{Swept: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 9999800))},
+	}, typedEvents(ctx))
+}
+
+func (suite *KeeperTestSuite) TestAirdropStartHeight() {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr1, nil, 0, 0))
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, []types.ClaimRecord{{
+		Address:                addr1.String(),
+		InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+		ActionCompleted:        []bool{false, false, false, false, false},
+	}})
+	suite.Require().NoError(err)
+
+	// a start time long in the past is ignored while the start height isn't reached
+	params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+	params.AirdropStartTime = suite.ctx.BlockTime().Add(-params.DurationUntilDecay - params.DurationOfDecay - time.Hour)
+	params.AirdropStartHeight = suite.ctx.BlockHeight() + 2
+	suite.app.ClaimKeeper.SetParams(suite.ctx, params)
+
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	claim.EndBlocker(ctx, suite.app.ClaimKeeper)
+	suite.Require().Equal(types.AirdropStageNotStarted, suite.app.ClaimKeeper.GetAirdropState(ctx).Stage)
+	suite.Require().Equal(params, suite.app.ClaimKeeper.GetParams(ctx))
+	coins, err := suite.app.ClaimKeeper.GetUserTotalClaimable(ctx, addr1)
+	suite.Require().NoError(err)
+	suite.Require().Empty(coins)
+
+	startTime := ctx.BlockTime().Add(time.Minute)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(startTime).WithEventManager(sdk.NewEventManager())
+	claim.EndBlocker(ctx, suite.app.ClaimKeeper)
+	suite.Require().Equal(types.AirdropStageActive, suite.app.ClaimKeeper.GetAirdropState(ctx).Stage)
+	params = suite.app.ClaimKeeper.GetParams(ctx)
+	suite.Require().Equal(int64(0), params.AirdropStartHeight)
+	suite.Require().True(startTime.Equal(params.AirdropStartTime))
+
+	started := false
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventAirdropStarted); ok {
+			suite.Require().Equal(ctx.BlockHeight(), e.Height)
+			started = true
+		}
+	}
+	suite.Require().True(started)
+
+	// decay is measured from the actual start
+	coins, err = suite.app.ClaimKeeper.GetUserTotalClaimable(ctx.WithBlockTime(startTime.Add(params.DurationUntilDecay)), addr1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)), coins)
+}
+
+func (suite *KeeperTestSuite) TestAirdropLifecycle() {
+	params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+	start := params.AirdropStartTime
+
+	for _, tc := range []struct {
+		name      string
+		blockTime time.Time
+		stage     types.AirdropStage
+		ends      bool
+	}{
+		{"not started", start.Add(-time.Minute), types.AirdropStageNotStarted, false},
+		{"active", start, types.AirdropStageActive, false},
+		{"decaying", start.Add(params.DurationUntilDecay + time.Minute), types.AirdropStageDecaying, false},
+		{"ended", start.Add(params.DurationUntilDecay + params.DurationOfDecay + time.Minute), types.AirdropStageEnded, true},
+		{"stays ended", start.Add(params.DurationUntilDecay + params.DurationOfDecay + time.Hour), types.AirdropStageEnded, false},
+	} {
+		suite.Run(tc.name, func() {
+			suite.ctx = suite.ctx.WithBlockTime(tc.blockTime).WithBlockHeight(suite.ctx.BlockHeight() + 1)
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			claim.EndBlocker(ctx, suite.app.ClaimKeeper)
+			suite.Require().Equal(tc.stage, suite.app.ClaimKeeper.GetAirdropState(ctx).Stage)
+
+			ended := false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeAirdropEnded {
+					suite.Require().False(ended)
+					ended = true
+				}
+			}
+			suite.Require().Equal(tc.ends, ended)
+			if tc.ends {
+				suite.Require().Equal(ctx.BlockHeight(), suite.app.ClaimKeeper.GetAirdropState(ctx).EndHeight)
+			}
+		})
+	}
 }
 
 // func (suite *KeeperTestSuite) TestAirdropFlow() {
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// IsFreeInitialClaimEligible returns true if the address can send its initial claim without paying fees:
+// the airdrop is running, the initial claim of its record is not completed and it didn't use its free claim yet
+func (k Keeper) IsFreeInitialClaimEligible(ctx sdk.Context, addr sdk.AccAddress) bool {
+	params := k.GetParams(ctx)
+	if !params.IsAirdropEnabled(ctx.BlockTime()) {
+		return false
+	}
+	if k.HasUsedFreeInitialClaim(ctx, addr) {
+		return false
+	}
+	claimRecord, err := k.GetClaimRecord(ctx, addr)
+	if err != nil || claimRecord.Address == "" {
+		return false
+	}
+	return !claimRecord.ActionCompleted[types.ActionInitialClaim]
+}
+
+// HasUsedFreeInitialClaim returns true if the address already sent its fee-free initial claim
+func (k Keeper) HasUsedFreeInitialClaim(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.FreeInitialClaimsStorePrefix)
+	return prefixStore.Has(addr)
+}
+
+// SetFreeInitialClaimUsed records that the address sent its fee-free initial claim.
+// Once the initial claim is completed the claim record prevents further free claims,
+// this only guards against failed claims being retried for free.
+func (k Keeper) SetFreeInitialClaimUsed(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.FreeInitialClaimsStorePrefix)
+	prefixStore.Set(addr, []byte{0x01})
+}
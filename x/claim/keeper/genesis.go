@@ -9,15 +9,60 @@ import (
 )
 
 func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) []abci.ValidatorUpdate {
-	k.CreateModuleAccount(ctx, data.ModuleAccountBalance)
-	if data.Params.AirdropEnabled && data.Params.AirdropStartTime.Equal(time.Time{}) {
+	if data.Params.AirdropEnabled && data.Params.AirdropStartTime.Equal(time.Time{}) && !data.Params.IsAirdropStartPending() {
 		data.Params.AirdropStartTime = ctx.BlockTime()
 	}
-	err := k.SetClaimRecords(ctx, data.ClaimRecords)
-	if err != nil {
-		panic(err)
-	}
 	k.SetParams(ctx, data.Params)
+
+	// the airdrop totals are recomputed from the claim records in a single pass
+	state := data.AirdropState
+	state.TotalAllocated = sdk.Coins{}
+	state.FullyClaimedAddresses = 0
+	weights := data.Params.EffectiveActionWeights()
+	for _, claimRecord := range data.ClaimRecords {
+		addr, err := sdk.AccAddressFromBech32(claimRecord.Address)
+		if err != nil {
+			panic(err)
+		}
+		claimRecord.FullyClaimed = isFullyClaimed(weights, claimRecord)
+		if err := k.setClaimRecord(ctx, addr, claimRecord); err != nil {
+			panic(err)
+		}
+		state.TotalAllocated = state.TotalAllocated.Add(claimRecord.InitialClaimableAmount...)
+		if claimRecord.FullyClaimed {
+			state.FullyClaimedAddresses++
+		}
+	}
+	k.SetAirdropState(ctx, state)
+
+	// campaign and vesting escrow funds are held by the module account through the bank genesis
+	nextCampaignID := uint64(1)
+	for _, campaign := range data.Campaigns {
+		k.SetCampaign(ctx, campaign)
+		if campaign.Id >= nextCampaignID {
+			nextCampaignID = campaign.Id + 1
+		}
+	}
+	k.SetNextCampaignID(ctx, nextCampaignID)
+	for _, record := range data.CampaignClaimRecords {
+		err := k.SetCampaignClaimRecord(ctx, record.CampaignId, record.ClaimRecord)
+		if err != nil {
+			panic(err)
+		}
+	}
+	for _, escrow := range data.VestingEscrows {
+		err := k.SetVestingEscrow(ctx, escrow)
+		if err != nil {
+			panic(err)
+		}
+	}
+	for _, record := range data.ClaimHistory {
+		err := k.SetClaimHistoryRecord(ctx, record)
+		if err != nil {
+			panic(err)
+		}
+	}
+	k.CreateModuleAccount(ctx, data.ModuleAccountBalance)
 	return nil
 }
 
@@ -26,8 +71,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 
 	// this line is used by starport scaffolding # genesis/module/export
 	params := k.GetParams(ctx)
-	genesis.ModuleAccountBalance = k.GetModuleAccountBalance(ctx)
+	genesis.ModuleAccountBalance = k.GetAirdropBalance(ctx)
 	genesis.Params = params
-	genesis.ClaimRecords = k.ClaimRecords(ctx)
+	genesis.ClaimRecords = k.GetClaimRecords(ctx)
+	genesis.AirdropState = k.GetAirdropState(ctx)
+	genesis.Campaigns = k.GetAllCampaigns(ctx)
+	genesis.CampaignClaimRecords = k.AllCampaignClaimRecords(ctx)
+	genesis.VestingEscrows = k.GetAllVestingEscrows(ctx)
+	genesis.ClaimHistory = k.GetAllClaimHistory(ctx)
 	return genesis
 }
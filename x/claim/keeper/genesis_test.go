@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/public-awesome/stargaze/testutil/sample"
 	"github.com/public-awesome/stargaze/x/claim/types"
 )
 
@@ -13,3 +17,104 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	params.AirdropStartTime = ctx.BlockTime()
 	s.Require().Equal(params.AllowedClaimers, exported.Params.AllowedClaimers)
 }
+
+func (s *KeeperTestSuite) TestExportGenesisAirdropState() {
+	app, ctx := s.app, s.ctx
+	genesis := types.DefaultGenesis()
+	genesis.AirdropState = types.AirdropState{Stage: types.AirdropStageEnded, EndHeight: 42}
+	app.ClaimKeeper.InitGenesis(ctx, *genesis)
+	exported := app.ClaimKeeper.ExportGenesis(ctx)
+	s.Require().Equal(genesis.AirdropState, exported.AirdropState)
+}
+
+func (s *KeeperTestSuite) TestExportGenesisClaimHistory() {
+	app, ctx := s.app, s.ctx
+	genesis := types.DefaultGenesis()
+	genesis.AirdropState = types.AirdropState{Stage: types.AirdropStageEnded, EndHeight: 42}
+	genesis.ClaimHistory = []types.ClaimHistoryRecord{
+		{
+			Address:    sample.AccAddress(),
+			CampaignId: 1,
+			Claim: types.ActionClaim{
+				Action: types.ActionMintNFT,
+				Amount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 100)),
+				Height: 10,
+				Time:   ctx.BlockTime().UTC(),
+			},
+		},
+	}
+	app.ClaimKeeper.InitGenesis(ctx, *genesis)
+	exported := app.ClaimKeeper.ExportGenesis(ctx)
+	s.Require().Equal(genesis.ClaimHistory, exported.ClaimHistory)
+}
+
+func (s *KeeperTestSuite) TestInitGenesisAirdropTotals() {
+	app, ctx := s.app, s.ctx
+	genesis := types.DefaultGenesis()
+	genesis.ClaimRecords = []types.ClaimRecord{
+		{
+			Address:                sample.AccAddress(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 100)),
+			ActionCompleted:        []bool{true, true, true, true, true},
+		},
+		{
+			Address:                sample.AccAddress(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 200)),
+			ActionCompleted:        []bool{true, false, false, false, false},
+		},
+	}
+	// totals of the genesis airdrop state are recomputed from the claim records
+	genesis.AirdropState.TotalAllocated = sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1))
+	genesis.AirdropState.FullyClaimedAddresses = 5
+	app.ClaimKeeper.InitGenesis(ctx, *genesis)
+
+	state := app.ClaimKeeper.GetAirdropState(ctx)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 300)), state.TotalAllocated)
+	s.Require().Equal(uint64(1), state.FullyClaimedAddresses)
+}
+
+func (s *KeeperTestSuite) TestExportGenesisCampaigns() {
+	app, ctx := s.app, s.ctx
+	genesis := types.DefaultGenesis()
+	genesis.Campaigns = []types.Campaign{{
+		Id:                 3,
+		Name:               "nft creators",
+		Denom:              types.DefaultClaimDenom,
+		StartTime:          ctx.BlockTime().UTC(),
+		DurationUntilDecay: time.Hour,
+		DurationOfDecay:    time.Hour,
+		DecayFunction:      types.NewLinearDecay(),
+		Funder:             sample.AccAddress(),
+		Balance:            sdk.NewInt64Coin(types.DefaultClaimDenom, 100),
+	}}
+	genesis.CampaignClaimRecords = []types.CampaignClaimRecord{{
+		CampaignId: 3,
+		ClaimRecord: types.ClaimRecord{
+			Address:                sample.AccAddress(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 100)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+	}}
+	app.ClaimKeeper.InitGenesis(ctx, *genesis)
+	s.Require().Equal(uint64(4), app.ClaimKeeper.GetNextCampaignID(ctx))
+
+	exported := app.ClaimKeeper.ExportGenesis(ctx)
+	s.Require().Equal(genesis.Campaigns, exported.Campaigns)
+	s.Require().Equal(genesis.CampaignClaimRecords, exported.CampaignClaimRecords)
+}
+
+func (s *KeeperTestSuite) TestInitGenesisHeldBalance() {
+	app, ctx := s.app, s.ctx
+	supply := app.BankKeeper.GetSupply(ctx, types.DefaultClaimDenom)
+
+	// the module account already holds the airdrop balance as in an imported state
+	genesis := types.DefaultGenesis()
+	genesis.ModuleAccountBalance = sdk.NewInt64Coin(types.DefaultClaimDenom, 10000000)
+	app.ClaimKeeper.InitGenesis(ctx, *genesis)
+	s.Require().Equal(supply, app.BankKeeper.GetSupply(ctx, types.DefaultClaimDenom))
+
+	genesis.ModuleAccountBalance = sdk.NewInt64Coin(types.DefaultClaimDenom, 10000100)
+	app.ClaimKeeper.InitGenesis(ctx, *genesis)
+	s.Require().Equal(supply.AddAmount(sdk.NewInt(100)), app.BankKeeper.GetSupply(ctx, types.DefaultClaimDenom))
+	s.Require().Equal(genesis.ModuleAccountBalance, app.ClaimKeeper.ExportGenesis(ctx).ModuleAccountBalance)
+}
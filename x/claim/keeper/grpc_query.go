@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"context"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/public-awesome/stargaze/x/claim/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -69,6 +73,12 @@ func (k Keeper) ClaimableForAction(
 	}, err
 }
 
+// AirdropState returns the airdrop lifecycle state
+func (k Keeper) AirdropState(c context.Context, _ *types.QueryAirdropStateRequest) (*types.QueryAirdropStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAirdropStateResponse{AirdropState: k.GetAirdropState(ctx)}, nil
+}
+
 // Activities returns activities
 func (k Keeper) TotalClaimable(
 	goCtx context.Context,
@@ -90,3 +100,179 @@ func (k Keeper) TotalClaimable(
 		Coins: coins,
 	}, err
 }
+
+// ClaimableSchedule returns the projected total claimable amount for an address at future times
+func (k Keeper) ClaimableSchedule(
+	goCtx context.Context,
+	req *types.QueryClaimableScheduleRequest,
+) (*types.QueryClaimableScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Count == 0 || req.Count > types.MaxClaimableScheduleCount {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be between 1 and %d", types.MaxClaimableScheduleCount)
+	}
+	if req.Interval <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "interval must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := make([]types.ClaimableAtTime, 0, req.Count)
+	for i := uint32(0); i < req.Count; i++ {
+		t := ctx.BlockTime().Add(req.Interval * time.Duration(i))
+		coins, err := k.GetUserTotalClaimable(ctx.WithBlockTime(t), addr)
+		if err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, types.ClaimableAtTime{Time: t, Coins: coins})
+	}
+
+	return &types.QueryClaimableScheduleResponse{Schedule: schedule}, nil
+}
+
+// Campaigns returns all campaigns
+func (k Keeper) Campaigns(
+	goCtx context.Context,
+	req *types.QueryCampaignsRequest,
+) (*types.QueryCampaignsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CampaignsStorePrefix)
+
+	campaigns := []types.Campaign{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var campaign types.Campaign
+		if err := k.cdc.Unmarshal(value, &campaign); err != nil {
+			return err
+		}
+		campaigns = append(campaigns, campaign)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCampaignsResponse{Campaigns: campaigns, Pagination: pageRes}, nil
+}
+
+// Campaign returns a campaign by id
+func (k Keeper) Campaign(
+	goCtx context.Context,
+	req *types.QueryCampaignRequest,
+) (*types.QueryCampaignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	campaign, found := k.GetCampaign(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "campaign %d", req.Id)
+	}
+	return &types.QueryCampaignResponse{Campaign: campaign}, nil
+}
+
+// CampaignClaimRecord returns the claim record of a campaign for an address
+func (k Keeper) CampaignClaimRecord(
+	goCtx context.Context,
+	req *types.QueryCampaignClaimRecordRequest,
+) (*types.QueryCampaignClaimRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetCampaign(ctx, req.CampaignId); !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "campaign %d", req.CampaignId)
+	}
+	claimRecord, err := k.GetCampaignClaimRecord(ctx, req.CampaignId, addr)
+	return &types.QueryCampaignClaimRecordResponse{ClaimRecord: claimRecord}, err
+}
+
+// ClaimRecords returns all claim records of the airdrop
+func (k Keeper) ClaimRecords(
+	goCtx context.Context,
+	req *types.QueryClaimRecordsRequest,
+) (*types.QueryClaimRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClaimRecordsStorePrefix)
+
+	claimRecords := []types.ClaimRecord{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var claimRecord types.ClaimRecord
+		if err := k.cdc.Unmarshal(value, &claimRecord); err != nil {
+			return err
+		}
+		claimRecords = append(claimRecords, claimRecord)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimRecordsResponse{ClaimRecords: claimRecords, Pagination: pageRes}, nil
+}
+
+// AirdropStats returns the aggregate progress of the airdrop
+func (k Keeper) AirdropStats(c context.Context, _ *types.QueryAirdropStatsRequest) (*types.QueryAirdropStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	stats := k.GetAirdropStats(ctx)
+	return &stats, nil
+}
+
+// ClaimHistory returns the amounts received by an address from the airdrop and all campaigns
+func (k Keeper) ClaimHistory(
+	goCtx context.Context,
+	req *types.QueryClaimHistoryRequest,
+) (*types.QueryClaimHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryClaimHistoryResponse{
+		History:      []types.ClaimHistoryEntry{},
+		TotalClaimed: sdk.Coins{},
+	}
+	for _, record := range k.GetClaimHistory(ctx, addr) {
+		res.History = append(res.History, types.ClaimHistoryEntry{CampaignId: record.CampaignId, Claim: record.Claim})
+		res.TotalClaimed = res.TotalClaimed.Add(record.Claim.Amount...)
+	}
+	return res, nil
+}
+
+// VestingEscrow returns the vesting escrow of an address
+func (k Keeper) VestingEscrow(
+	goCtx context.Context,
+	req *types.QueryVestingEscrowRequest,
+) (*types.QueryVestingEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	escrow := k.GetVestingEscrow(ctx, addr)
+	return &types.QueryVestingEscrowResponse{
+		VestingEscrow: escrow,
+		Releasable:    escrow.Releasable(ctx.BlockTime()),
+	}, nil
+}
@@ -25,6 +25,20 @@ func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
 	}
 }
 
+func (k Keeper) AfterMintNFT(ctx sdk.Context, minterAddr sdk.AccAddress) {
+	_, err := k.ClaimCoinsForAction(ctx, minterAddr, types.ActionMintNFT)
+	if err != nil {
+		panic(err.Error())
+	}
+}
+
+func (k Keeper) AfterBuySocialToken(ctx sdk.Context, buyerAddr sdk.AccAddress) {
+	_, err := k.ClaimCoinsForAction(ctx, buyerAddr, types.ActionBuySocialToken)
+	if err != nil {
+		panic(err.Error())
+	}
+}
+
 // ________________________________________________________________________________________
 
 // Hooks wrapper struct for slashing keeper
@@ -34,6 +48,7 @@ type Hooks struct {
 
 var _ govtypes.GovHooks = Hooks{}
 var _ stakingtypes.StakingHooks = Hooks{}
+var _ types.ClaimHooks = Hooks{}
 
 // Return the wrapper struct
 func (k Keeper) Hooks() Hooks {
@@ -74,3 +89,12 @@ func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
 	h.k.AfterDelegationModified(ctx, delAddr, valAddr)
 }
 func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {}
+
+// claim hooks
+func (h Hooks) AfterMintNFT(ctx sdk.Context, minterAddr sdk.AccAddress) {
+	h.k.AfterMintNFT(ctx, minterAddr)
+}
+
+func (h Hooks) AfterBuySocialToken(ctx sdk.Context, buyerAddr sdk.AccAddress) {
+	h.k.AfterBuySocialToken(ctx, buyerAddr)
+}
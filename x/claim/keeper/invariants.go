@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// RegisterInvariants registers all claim invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "claim-record-actions", ClaimRecordActionsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "no-records-after-end", NoRecordsAfterEndInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "campaign-balances", CampaignBalancesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the claim module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ClaimRecordActionsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = NoRecordsAfterEndInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return CampaignBalancesInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountBalanceInvariant checks that the module account balance covers
+// the unclaimed portion of all claim records, the balances of all campaigns and
+// the unreleased vesting escrows
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		records := k.GetClaimRecords(ctx)
+		campaignBalances := k.CampaignBalances(ctx).Add(k.VestingEscrowBalances(ctx)...)
+		if len(records) == 0 && campaignBalances.Empty() {
+			// crisis asserts invariants at genesis before the claim params are set
+			return sdk.FormatInvariant(types.ModuleName, "module-account-balance", "no claim records\n"), false
+		}
+		unclaimed := campaignBalances
+		if len(records) > 0 {
+			unclaimed = unclaimed.Add(unclaimedAmount(k.GetParams(ctx).EffectiveActionWeights(), records)...)
+		}
+
+		moduleAccAddr := k.GetModuleAccountAddress(ctx)
+		balance := sdk.Coins{}
+		for _, coin := range unclaimed {
+			balance = balance.Add(k.bankKeeper.GetBalance(ctx, moduleAccAddr, coin.Denom))
+		}
+		broken := !balance.IsAllGTE(unclaimed)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-account-balance",
+			fmt.Sprintf("\tclaim module account balance: %s\n\tunclaimed amount in claim records: %s\n",
+				balance, unclaimed)), broken
+	}
+}
+
+// ClaimRecordActionsInvariant checks that every claim record tracks every action
+func ClaimRecordActionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		for _, record := range k.GetClaimRecords(ctx) {
+			if len(record.ActionCompleted) != len(types.Action_name) {
+				count++
+				msg += fmt.Sprintf("\t%s has %d actions, expected %d\n",
+					record.Address, len(record.ActionCompleted), len(types.Action_name))
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "claim-record-actions",
+			fmt.Sprintf("%d claim records with invalid actions found\n%s", count, msg)), broken
+	}
+}
+
+// NoRecordsAfterEndInvariant checks that no claim records remain once the airdrop has ended
+func NoRecordsAfterEndInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if k.GetAirdropState(ctx).Stage != types.AirdropStageEnded {
+			return sdk.FormatInvariant(types.ModuleName, "no-records-after-end", "airdrop has not ended\n"), false
+		}
+		count := len(k.GetClaimRecords(ctx))
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "no-records-after-end",
+			fmt.Sprintf("%d claim records found after the airdrop ended\n", count)), broken
+	}
+}
+
+// CampaignBalancesInvariant checks that every campaign balance covers the unclaimed portion
+// of its claim records and that no claim records remain once a campaign has ended
+func CampaignBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		k.IterateCampaigns(ctx, func(campaign types.Campaign) bool {
+			records := k.CampaignClaimRecords(ctx, campaign.Id)
+			if campaign.Ended {
+				if len(records) != 0 {
+					count++
+					msg += fmt.Sprintf("\tcampaign %d ended with %d claim records\n", campaign.Id, len(records))
+				}
+				return false
+			}
+			unclaimed := unclaimedAmount(campaign.EffectiveActionWeights(), records)
+			if !sdk.NewCoins(campaign.Balance).IsAllGTE(unclaimed) {
+				count++
+				msg += fmt.Sprintf("\tcampaign %d balance %s is less than unclaimed amount %s\n", campaign.Id, campaign.Balance, unclaimed)
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "campaign-balances",
+			fmt.Sprintf("%d campaigns with invalid balances found\n%s", count, msg)), broken
+	}
+}
+
+// unclaimedAmount returns the initial claimable amount of all actions not completed yet
+func unclaimedAmount(weights []types.ActionWeight, records []types.ClaimRecord) sdk.Coins {
+	unclaimed := sdk.Coins{}
+	for _, record := range records {
+		for action := range types.Action_name {
+			if int(action) < len(record.ActionCompleted) && record.ActionCompleted[action] {
+				continue
+			}
+			unclaimed = unclaimed.Add(initialClaimableForAction(weights, record, types.Action(action))...)
+		}
+	}
+	return unclaimed
+}
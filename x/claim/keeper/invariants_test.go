@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/public-awesome/stargaze/x/claim/keeper"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+func (suite *KeeperTestSuite) TestModuleAccountBalanceInvariant() {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// module account holds 10000000ustars
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, []types.ClaimRecord{
+		{
+			Address:                addr1.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 5000000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+		{
+			Address:                addr2.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 5000000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+	})
+	suite.Require().NoError(err)
+
+	_, broken := keeper.ModuleAccountBalanceInvariant(suite.app.ClaimKeeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// claimed actions no longer need to be covered
+	suite.app.ClaimKeeper.AfterProposalVote(suite.ctx, 1, addr1)
+	_, broken = keeper.ModuleAccountBalanceInvariant(suite.app.ClaimKeeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// a record added without funding breaks the invariant
+	err = suite.app.ClaimKeeper.SetClaimRecord(suite.ctx, types.ClaimRecord{
+		Address:                sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 5000000)),
+		ActionCompleted:        []bool{false, false, false, false, false},
+	})
+	suite.Require().NoError(err)
+	_, broken = keeper.ModuleAccountBalanceInvariant(suite.app.ClaimKeeper)(suite.ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestClaimRecordActionsInvariant() {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err := suite.app.ClaimKeeper.SetClaimRecord(suite.ctx, types.ClaimRecord{
+		Address:                addr1.String(),
+		InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+		ActionCompleted:        []bool{false, false, false, false, false},
+	})
+	suite.Require().NoError(err)
+	_, broken := keeper.ClaimRecordActionsInvariant(suite.app.ClaimKeeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	err = suite.app.ClaimKeeper.SetClaimRecord(suite.ctx, types.ClaimRecord{
+		Address:                addr1.String(),
+		InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+		ActionCompleted:        []bool{false, false, false, false},
+	})
+	suite.Require().NoError(err)
+	_, broken = keeper.ClaimRecordActionsInvariant(suite.app.ClaimKeeper)(suite.ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestNoRecordsAfterEndInvariant() {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	record := types.ClaimRecord{
+		Address:                addr1.String(),
+		InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+		ActionCompleted:        []bool{false, false, false, false, false},
+	}
+	err := suite.app.ClaimKeeper.SetClaimRecord(suite.ctx, record)
+	suite.Require().NoError(err)
+	_, broken := keeper.NoRecordsAfterEndInvariant(suite.app.ClaimKeeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	err = suite.app.ClaimKeeper.EndAirdrop(suite.ctx)
+	suite.Require().NoError(err)
+	_, broken = keeper.AllInvariants(suite.app.ClaimKeeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	err = suite.app.ClaimKeeper.SetClaimRecord(suite.ctx, record)
+	suite.Require().NoError(err)
+	_, broken = keeper.NoRecordsAfterEndInvariant(suite.app.ClaimKeeper)(suite.ctx)
+	suite.Require().True(broken)
+}
@@ -21,6 +21,7 @@ type (
 		bankKeeper    types.BankKeeper
 		stakingKeeper types.StakingKeeper
 		distrKeeper   types.DistrKeeper
+		mintKeeper    types.MintKeeper
 
 		paramstore paramtypes.Subspace
 	}
@@ -32,6 +33,7 @@ func NewKeeper(
 	memKey sdk.StoreKey,
 	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper, distrKeeper types.DistrKeeper,
+	mintKeeper types.MintKeeper,
 	ps paramtypes.Subspace,
 ) *Keeper {
 
@@ -48,6 +50,7 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		stakingKeeper: stakingKeeper,
 		distrKeeper:   distrKeeper,
+		mintKeeper:    mintKeeper,
 		paramstore:    ps,
 	}
 }
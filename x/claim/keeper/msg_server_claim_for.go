@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+func (k msgServer) ClaimFor(goCtx context.Context, msg *types.MsgClaimFor) (*types.MsgClaimForResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	params := k.GetParams(ctx)
+	if !params.IsAirdropEnabled(ctx.BlockTime()) && !k.IsAnyCampaignActive(ctx) {
+		return nil, types.ErrAirdropNotEnabled
+	}
+	if !params.IsAllowedClaimer(msg.Sender, msg.Action) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorizedClaimer, "%s is not allowed to claim %s", msg.Sender, msg.Action)
+	}
+	coins, err := k.Keeper.ClaimCoinsForAction(ctx, address, msg.Action)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+	return &types.MsgClaimForResponse{
+		ClaimedAmount: coins,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/public-awesome/stargaze/x/claim/keeper"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+func (suite *KeeperTestSuite) TestClaimFor() {
+	pub1 := secp256k1.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pub1.Address())
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	claimRecords := []types.ClaimRecord{
+		{
+			Address:                addr1.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 2000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr1, nil, 0, 0))
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, claimRecords)
+	suite.Require().NoError(err)
+
+	params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+	params.AllowedClaimers = []types.ClaimAuthorization{
+		{ContractAddress: contract.String(), Action: types.ActionMintNFT},
+	}
+	suite.app.ClaimKeeper.SetParams(suite.ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.ClaimKeeper)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	// claimer not allowed for this action
+	_, err = msgServer.ClaimFor(goCtx, types.NewMsgClaimFor(contract.String(), addr1.String(), types.ActionBuySocialToken))
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedClaimer)
+
+	// sender is not an allowed claimer
+	_, err = msgServer.ClaimFor(goCtx, types.NewMsgClaimFor(addr1.String(), addr1.String(), types.ActionMintNFT))
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedClaimer)
+
+	res, err := msgServer.ClaimFor(goCtx, types.NewMsgClaimFor(contract.String(), addr1.String(), types.ActionMintNFT))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 400)), res.ClaimedAmount)
+
+	claim, err := suite.app.ClaimKeeper.GetClaimRecord(suite.ctx, addr1)
+	suite.Require().NoError(err)
+	suite.Require().True(claim.ActionCompleted[types.ActionMintNFT])
+	suite.Require().Equal(res.ClaimedAmount, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+
+	// second claim for the same action does not pay out again
+	res, err = msgServer.ClaimFor(goCtx, types.NewMsgClaimFor(contract.String(), addr1.String(), types.ActionMintNFT))
+	suite.Require().NoError(err)
+	suite.Require().True(res.ClaimedAmount.IsZero())
+}
@@ -14,7 +14,7 @@ func (k msgServer) InitialClaim(goCtx context.Context, msg *types.MsgInitialClai
 		return nil, err
 	}
 	params := k.GetParams(ctx)
-	if !params.IsAirdropEnabled(ctx.BlockTime()) {
+	if !params.IsAirdropEnabled(ctx.BlockTime()) && !k.IsAnyCampaignActive(ctx) {
 		return nil, types.ErrAirdropNotEnabled
 	}
 	coins, err := k.Keeper.ClaimCoinsForAction(ctx, sender, types.ActionInitialClaim)
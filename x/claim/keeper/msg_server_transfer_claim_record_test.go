@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/public-awesome/stargaze/x/claim/keeper"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+func (suite *KeeperTestSuite) TestTransferClaimRecord() {
+	addrs := make([]sdk.AccAddress, 4)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	records := []types.ClaimRecord{}
+	for _, addr := range []sdk.AccAddress{addrs[0], addrs[2]} {
+		records = append(records, types.ClaimRecord{
+			Address:                addr.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		})
+	}
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, records)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addrs[0], types.ActionInitialClaim)
+	suite.Require().NoError(err)
+
+	// transfer the record halfway through the decay
+	params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+	ctx := suite.ctx.WithBlockTime(params.AirdropStartTime.Add(params.DurationUntilDecay + params.DurationOfDecay/2))
+	msgServer := keeper.NewMsgServerImpl(suite.app.ClaimKeeper)
+	_, err = msgServer.TransferClaimRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferClaimRecord(addrs[0].String(), addrs[1].String()))
+	suite.Require().NoError(err)
+
+	record, err := suite.app.ClaimKeeper.GetClaimRecord(ctx, addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal("", record.Address)
+	record, err = suite.app.ClaimKeeper.GetClaimRecord(ctx, addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[1].String(), record.Address)
+
+	// the claim history stays with the address which claimed
+	suite.Require().Len(suite.app.ClaimKeeper.GetClaimHistory(ctx, addrs[0]), 1)
+	suite.Require().Empty(suite.app.ClaimKeeper.GetClaimHistory(ctx, addrs[1]))
+
+	// the transferred record keeps its completed actions and the airdrop decay
+	claimed, err := suite.app.ClaimKeeper.ClaimCoinsForAction(ctx, addrs[1], types.ActionInitialClaim)
+	suite.Require().NoError(err)
+	suite.Require().True(claimed.Empty())
+	claimable, err := suite.app.ClaimKeeper.GetUserTotalClaimable(ctx, addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 400)), claimable)
+	claimed, err = suite.app.ClaimKeeper.ClaimCoinsForAction(ctx, addrs[1], types.ActionMintNFT)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 100)), claimed)
+
+	// the old address has nothing left to claim
+	claimed, err = suite.app.ClaimKeeper.ClaimCoinsForAction(ctx, addrs[0], types.ActionVote)
+	suite.Require().NoError(err)
+	suite.Require().True(claimed.Empty())
+
+	// an existing record is never overwritten
+	_, err = msgServer.TransferClaimRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferClaimRecord(addrs[1].String(), addrs[2].String()))
+	suite.Require().ErrorIs(err, types.ErrClaimRecordExists)
+	_, err = msgServer.TransferClaimRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferClaimRecord(addrs[0].String(), addrs[3].String()))
+	suite.Require().ErrorIs(err, types.ErrClaimRecordNotFound)
+
+	// claimed records cannot move once transfers after claim are disabled
+	params.DisableTransferAfterClaim = true
+	suite.app.ClaimKeeper.SetParams(ctx, params)
+	_, err = msgServer.TransferClaimRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferClaimRecord(addrs[1].String(), addrs[3].String()))
+	suite.Require().ErrorIs(err, types.ErrClaimRecordTransferDisabled)
+	_, err = msgServer.TransferClaimRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferClaimRecord(addrs[2].String(), addrs[3].String()))
+	suite.Require().NoError(err)
+
+	// nothing is claimable once the decay is over
+	ctx = suite.ctx.WithBlockTime(params.AirdropStartTime.Add(params.DurationUntilDecay + params.DurationOfDecay + 1))
+	claimable, err = suite.app.ClaimKeeper.GetUserTotalClaimable(ctx, addrs[3])
+	suite.Require().NoError(err)
+	suite.Require().True(claimable.Empty())
+}
+
+func (suite *KeeperTestSuite) TestTransferClaimRecordCampaigns() {
+	addrs := make([]sdk.AccAddress, 4)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err := FundAccount(suite.app.BankKeeper, suite.ctx, creator, sdk.NewCoins(sdk.NewInt64Coin("uatom", 3000)))
+	suite.Require().NoError(err)
+	params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+	params.CampaignCreators = []string{creator.String()}
+	suite.app.ClaimKeeper.SetParams(suite.ctx, params)
+
+	// addrs[0] only has campaign records, addrs[2] has one in the first campaign as well
+	msgServer := keeper.NewMsgServerImpl(suite.app.ClaimKeeper)
+	for _, records := range [][]types.ClaimRecord{
+		{
+			{Address: addrs[0].String(), InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))},
+			{Address: addrs[2].String(), InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))},
+		},
+		{
+			{Address: addrs[0].String(), InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))},
+		},
+	} {
+		_, err = msgServer.CreateCampaign(sdk.WrapSDKContext(suite.ctx), types.NewMsgCreateCampaign(
+			creator.String(), "campaign", "uatom", time.Time{}, time.Hour, time.Hour, types.NewLinearDecay(), nil, records,
+		))
+		suite.Require().NoError(err)
+	}
+
+	// campaign records are never overwritten
+	_, err = msgServer.TransferClaimRecord(sdk.WrapSDKContext(suite.ctx), types.NewMsgTransferClaimRecord(addrs[0].String(), addrs[2].String()))
+	suite.Require().ErrorIs(err, types.ErrClaimRecordExists)
+	record, err := suite.app.ClaimKeeper.GetCampaignClaimRecord(suite.ctx, 2, addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[0].String(), record.Address)
+
+	// claimed campaign records cannot move once transfers after claim are disabled
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addrs[0], types.ActionInitialClaim)
+	suite.Require().NoError(err)
+	params.DisableTransferAfterClaim = true
+	suite.app.ClaimKeeper.SetParams(suite.ctx, params)
+	ctx, _ := suite.ctx.CacheContext()
+	_, err = msgServer.TransferClaimRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferClaimRecord(addrs[0].String(), addrs[1].String()))
+	suite.Require().ErrorIs(err, types.ErrClaimRecordTransferDisabled)
+
+	// both campaign records move with their completed actions
+	params.DisableTransferAfterClaim = false
+	suite.app.ClaimKeeper.SetParams(suite.ctx, params)
+	_, err = msgServer.TransferClaimRecord(sdk.WrapSDKContext(suite.ctx), types.NewMsgTransferClaimRecord(addrs[0].String(), addrs[1].String()))
+	suite.Require().NoError(err)
+	for _, id := range []uint64{1, 2} {
+		record, err := suite.app.ClaimKeeper.GetCampaignClaimRecord(suite.ctx, id, addrs[0])
+		suite.Require().NoError(err)
+		suite.Require().Empty(record.Address)
+		record, err = suite.app.ClaimKeeper.GetCampaignClaimRecord(suite.ctx, id, addrs[1])
+		suite.Require().NoError(err)
+		suite.Require().Equal(addrs[1].String(), record.Address)
+		suite.Require().True(record.ActionCompleted[types.ActionInitialClaim])
+	}
+	claimed, err := suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addrs[1], types.ActionMintNFT)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 400)), claimed)
+
+	// nothing is left to move
+	_, err = msgServer.TransferClaimRecord(sdk.WrapSDKContext(suite.ctx), types.NewMsgTransferClaimRecord(addrs[0].String(), addrs[3].String()))
+	suite.Require().ErrorIs(err, types.ErrClaimRecordNotFound)
+}
@@ -11,7 +11,17 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
-// SetParams sets claim parameters to the param space.
+// SetParams sets claim parameters to the param space. It panics on params that are
+// invalid as a whole, e.g. a vesting fraction without a vesting duration.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// ValidateParams validates the stored claim parameters as a whole. Param change proposals
+// only run the per-field validators, so the checks across fields are done here.
+func (k Keeper) ValidateParams(ctx sdk.Context) error {
+	return k.GetParams(ctx).Validate()
+}
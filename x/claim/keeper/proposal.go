@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// HandleClaimRecordsUpdateProposal adds, adjusts and revokes claim records. The difference in
+// unclaimed amounts is minted to or burned from the module account so it keeps covering all records.
+// Mints above the mint module max supply are rejected.
+func HandleClaimRecordsUpdateProposal(ctx sdk.Context, k Keeper, p *types.ClaimRecordsUpdateProposal) error {
+	if k.GetAirdropState(ctx).Stage == types.AirdropStageEnded {
+		return types.ErrAirdropEnded
+	}
+	params := k.GetParams(ctx)
+	weights := params.EffectiveActionWeights()
+
+	added := sdk.Coins{}
+	removed := sdk.Coins{}
+	for _, update := range p.ClaimRecords {
+		for _, coin := range update.InitialClaimableAmount {
+			if coin.Denom != params.ClaimDenom {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "claim record of %s must be in %s: %s", update.Address, params.ClaimDenom, coin)
+			}
+		}
+		addr, err := sdk.AccAddressFromBech32(update.Address)
+		if err != nil {
+			return err
+		}
+		claimRecord, err := k.GetClaimRecord(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if claimRecord.Address == "" {
+			claimRecord = types.ClaimRecord{
+				Address:         update.Address,
+				ActionCompleted: make([]bool, len(types.Action_name)),
+			}
+		} else {
+			removed = removed.Add(unclaimedAmount(weights, []types.ClaimRecord{claimRecord})...)
+		}
+		claimRecord.InitialClaimableAmount = update.InitialClaimableAmount
+		added = added.Add(unclaimedAmount(weights, []types.ClaimRecord{claimRecord})...)
+
+		err = k.SetClaimRecord(ctx, claimRecord)
+		if err != nil {
+			return err
+		}
+	}
+	for _, address := range p.RevokedAddresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		claimRecord, err := k.GetClaimRecord(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if claimRecord.Address == "" {
+			return sdkerrors.Wrapf(types.ErrClaimRecordNotFound, "cannot revoke %s", address)
+		}
+		removed = removed.Add(unclaimedAmount(weights, []types.ClaimRecord{claimRecord})...)
+		if err := k.DeleteClaimRecord(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	// only the net difference is minted or burned
+	minted := sdk.Coins{}
+	burned := sdk.Coins{}
+	for _, coin := range added.Add(removed...) {
+		diff := added.AmountOf(coin.Denom).Sub(removed.AmountOf(coin.Denom))
+		switch {
+		case diff.IsPositive():
+			minted = minted.Add(sdk.NewCoin(coin.Denom, diff))
+		case diff.IsNegative():
+			burned = burned.Add(sdk.NewCoin(coin.Denom, diff.Neg()))
+		}
+	}
+	if !minted.Empty() {
+		if err := k.mintKeeper.ValidateExternalMint(ctx, minted); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, minted); err != nil {
+			return err
+		}
+	}
+	if !burned.Empty() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimRecordsUpdated,
+			sdk.NewAttribute(types.AttributeKeyMinted, minted.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+		),
+	})
+	return nil
+}
+
+// HandleCreateCampaignProposal creates a campaign funded from the community pool. The distribution
+// module account is recorded as the funder.
+func HandleCreateCampaignProposal(ctx sdk.Context, k Keeper, p *types.CreateCampaignProposal) error {
+	funder := k.accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	_, err := k.createCampaign(ctx, p.MsgCreateCampaign(funder.String()), func(total sdk.Coins) error {
+		return k.fundFromCommunityPool(ctx, total)
+	})
+	return err
+}
+
+// fundFromCommunityPool moves coins of the community pool to the module account
+func (k Keeper) fundFromCommunityPool(ctx sdk.Context, amount sdk.Coins) error {
+	feePool := k.distrKeeper.GetFeePool(ctx)
+	communityPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+	if negative {
+		return sdkerrors.Wrapf(distrtypes.ErrBadDistribution, "community pool does not have %s", amount)
+	}
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+	feePool.CommunityPool = communityPool
+	k.distrKeeper.SetFeePool(ctx, feePool)
+	return nil
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/public-awesome/stargaze/x/claim"
+	"github.com/public-awesome/stargaze/x/claim/keeper"
+	"github.com/public-awesome/stargaze/x/claim/types"
+	minttypes "github.com/public-awesome/stargaze/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestClaimRecordsUpdateProposal() {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, amount))
+	}
+
+	// fund the module account with exactly the claimable amount
+	moduleAddr := suite.app.ClaimKeeper.GetModuleAccountAddress(suite.ctx)
+	err := suite.app.BankKeeper.BurnCoins(suite.ctx, types.ModuleName, sdk.NewCoins(suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, types.DefaultClaimDenom)))
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins(3000))
+	suite.Require().NoError(err)
+	err = suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, []types.ClaimRecord{
+		{
+			Address:                addr1.String(),
+			InitialClaimableAmount: coins(1000),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+		{
+			Address:                addr2.String(),
+			InitialClaimableAmount: coins(2000),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		},
+	})
+	suite.Require().NoError(err)
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, addr1, types.ActionInitialClaim)
+	suite.Require().NoError(err)
+
+	handler := claim.NewClaimRecordsUpdateProposalHandler(suite.app.ClaimKeeper)
+
+	// revoking an unknown address fails
+	err = handler(suite.ctx, types.NewClaimRecordsUpdateProposal("title", "description", nil, []string{addr3.String()}))
+	suite.Require().ErrorIs(err, types.ErrClaimRecordNotFound)
+
+	// other denoms than the claim denom are rejected
+	err = handler(suite.ctx, types.NewClaimRecordsUpdateProposal("title", "description", []types.ClaimRecord{
+		{Address: addr3.String(), InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))},
+	}, nil))
+	suite.Require().Error(err)
+
+	// addr1 is raised to 2000 with one action completed (+800), addr3 is added (+500)
+	// and addr2 is revoked (-2000)
+	err = handler(suite.ctx, types.NewClaimRecordsUpdateProposal("title", "description", []types.ClaimRecord{
+		{Address: addr1.String(), InitialClaimableAmount: coins(2000)},
+		{Address: addr3.String(), InitialClaimableAmount: coins(500)},
+	}, []string{addr2.String()}))
+	suite.Require().NoError(err)
+
+	record1, err := suite.app.ClaimKeeper.GetClaimRecord(suite.ctx, addr1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins(2000), record1.InitialClaimableAmount)
+	suite.Require().True(record1.ActionCompleted[types.ActionInitialClaim])
+	suite.Require().Len(suite.app.ClaimKeeper.GetClaimHistory(suite.ctx, addr1), 1)
+
+	record2, err := suite.app.ClaimKeeper.GetClaimRecord(suite.ctx, addr2)
+	suite.Require().NoError(err)
+	suite.Require().Empty(record2.Address)
+
+	record3, err := suite.app.ClaimKeeper.GetClaimRecord(suite.ctx, addr3)
+	suite.Require().NoError(err)
+	suite.Require().Len(record3.ActionCompleted, len(types.Action_name))
+
+	// 3000 - 200 claimed + 800 + 500 - 2000
+	suite.Require().Equal(sdk.NewInt64Coin(types.DefaultClaimDenom, 2100), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, types.DefaultClaimDenom))
+	invariant, broken := keeper.AllInvariants(suite.app.ClaimKeeper)(suite.ctx)
+	suite.Require().False(broken, invariant)
+
+	// mints above the mint module max supply are rejected
+	addr4 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	mintParams := suite.app.MintKeeper.GetParams(suite.ctx)
+	mintParams.MintDenom = types.DefaultClaimDenom
+	mintParams.MaxSupply = suite.app.BankKeeper.GetSupply(suite.ctx, types.DefaultClaimDenom).Amount.AddRaw(100)
+	suite.app.MintKeeper.SetParams(suite.ctx, mintParams)
+	ctx, _ := suite.ctx.CacheContext()
+	err = handler(ctx, types.NewClaimRecordsUpdateProposal("title", "description", []types.ClaimRecord{
+		{Address: addr4.String(), InitialClaimableAmount: coins(500)},
+	}, nil))
+	suite.Require().ErrorIs(err, minttypes.ErrMaxSupplyExceeded)
+	err = handler(suite.ctx, types.NewClaimRecordsUpdateProposal("title", "description", []types.ClaimRecord{
+		{Address: addr4.String(), InitialClaimableAmount: coins(100)},
+	}, nil))
+	suite.Require().NoError(err)
+	remaining, _ := suite.app.MintKeeper.GetRemainingSupply(suite.ctx)
+	suite.Require().True(remaining.IsZero())
+
+	// no updates once the airdrop ended
+	suite.app.ClaimKeeper.SetAirdropState(suite.ctx, types.AirdropState{Stage: types.AirdropStageEnded})
+	err = handler(suite.ctx, types.NewClaimRecordsUpdateProposal("title", "description", nil, []string{addr1.String()}))
+	suite.Require().ErrorIs(err, types.ErrAirdropEnded)
+}
+
+func (suite *KeeperTestSuite) TestParamChangeProposalVesting() {
+	handler := claim.NewParamChangeProposalHandler(suite.app.ClaimKeeper, params.NewParamChangeProposalHandler(suite.app.ParamsKeeper))
+	vestingFraction := paramproposal.NewParamChange(types.ModuleName, string(types.KeyVestingFraction), `"0.500000000000000000"`)
+	vestingDuration := paramproposal.NewParamChange(types.ModuleName, string(types.KeyVestingDuration), `"86400000000000"`)
+
+	// a vesting fraction without a vesting duration is rejected
+	ctx, _ := suite.ctx.CacheContext()
+	err := handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{vestingFraction}))
+	suite.Require().Error(err)
+
+	// both can be changed together
+	ctx, _ = suite.ctx.CacheContext()
+	err = handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{vestingFraction, vestingDuration}))
+	suite.Require().NoError(err)
+	claimParams := suite.app.ClaimKeeper.GetParams(ctx)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), claimParams.VestingFraction)
+	suite.Require().Equal(24*time.Hour, claimParams.VestingDuration)
+
+	// the keeper refuses to set invalid params directly
+	claimParams.VestingDuration = 0
+	suite.Require().Panics(func() { suite.app.ClaimKeeper.SetParams(ctx, claimParams) })
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/public-awesome/stargaze/testutil/sample"
+	"github.com/public-awesome/stargaze/x/claim/keeper"
+	"github.com/public-awesome/stargaze/x/claim/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func (s *KeeperTestSuite) TestLegacyQuerier() {
+	app, ctx := s.app, s.ctx
+	legacyQuerierCdc := codec.NewAminoCodec(app.LegacyAmino())
+	querier := keeper.NewQuerier(app.ClaimKeeper, legacyQuerierCdc.LegacyAmino)
+
+	addr := sample.AccAddress()
+	err := app.ClaimKeeper.SetClaimRecords(ctx, []types.ClaimRecord{{
+		Address:                addr,
+		InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+		ActionCompleted:        []bool{false, false, false, false, false},
+	}})
+	s.Require().NoError(err)
+
+	var params types.Params
+	res, err := querier(ctx, []string{types.QueryParameters}, abci.RequestQuery{})
+	s.Require().NoError(err)
+	s.Require().NoError(app.LegacyAmino().UnmarshalJSON(res, &params))
+	s.Require().Equal(app.ClaimKeeper.GetParams(ctx).String(), params.String())
+
+	var claimRecord types.ClaimRecord
+	bz := app.LegacyAmino().MustMarshalJSON(types.QueryClaimRecordRequest{Address: addr})
+	res, err = querier(ctx, []string{types.QueryClaimRecord}, abci.RequestQuery{Data: bz})
+	s.Require().NoError(err)
+	s.Require().NoError(app.LegacyAmino().UnmarshalJSON(res, &claimRecord))
+	s.Require().Equal(addr, claimRecord.Address)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)), claimRecord.InitialClaimableAmount)
+
+	var coins sdk.Coins
+	bz = app.LegacyAmino().MustMarshalJSON(types.QueryClaimableForActionRequest{Address: addr, Action: types.ActionInitialClaim})
+	res, err = querier(ctx, []string{types.QueryClaimableForAction}, abci.RequestQuery{Data: bz})
+	s.Require().NoError(err)
+	s.Require().NoError(app.LegacyAmino().UnmarshalJSON(res, &coins))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 200)), coins)
+
+	bz = app.LegacyAmino().MustMarshalJSON(types.QueryClaimableForActionRequest{Address: addr, Action: types.Action(42)})
+	_, err = querier(ctx, []string{types.QueryClaimableForAction}, abci.RequestQuery{Data: bz})
+	s.Require().Error(err)
+
+	bz = app.LegacyAmino().MustMarshalJSON(types.QueryTotalClaimableRequest{Address: addr})
+	res, err = querier(ctx, []string{types.QueryTotalClaimable}, abci.RequestQuery{Data: bz})
+	s.Require().NoError(err)
+	s.Require().NoError(app.LegacyAmino().UnmarshalJSON(res, &coins))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)), coins)
+
+	bz = app.LegacyAmino().MustMarshalJSON(types.QueryTotalClaimableRequest{Address: "invalid"})
+	_, err = querier(ctx, []string{types.QueryTotalClaimable}, abci.RequestQuery{Data: bz})
+	s.Require().Error(err)
+
+	res, err = querier(ctx, []string{types.QueryModuleAccountBalance}, abci.RequestQuery{})
+	s.Require().NoError(err)
+	s.Require().NoError(app.LegacyAmino().UnmarshalJSON(res, &coins))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 10000000)), coins)
+
+	_, err = querier(ctx, []string{"foo"}, abci.RequestQuery{})
+	s.Require().Error(err)
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// SetVestingEscrow sets the vesting escrow of an address, removing it once empty
+func (k Keeper) SetVestingEscrow(ctx sdk.Context, escrow types.VestingEscrow) error {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.VestingEscrowsStorePrefix)
+
+	addr, err := sdk.AccAddressFromBech32(escrow.Address)
+	if err != nil {
+		return err
+	}
+	if len(escrow.Schedules) == 0 {
+		prefixStore.Delete(addr)
+		return nil
+	}
+	prefixStore.Set(addr, k.cdc.MustMarshal(&escrow))
+	return nil
+}
+
+// GetVestingEscrow returns the vesting escrow of an address
+func (k Keeper) GetVestingEscrow(ctx sdk.Context, addr sdk.AccAddress) types.VestingEscrow {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.VestingEscrowsStorePrefix)
+	bz := prefixStore.Get(addr)
+	if bz == nil {
+		return types.VestingEscrow{Address: addr.String()}
+	}
+	var escrow types.VestingEscrow
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow
+}
+
+// IterateVestingEscrows iterates over all vesting escrows, stopping when the callback returns true
+func (k Keeper) IterateVestingEscrows(ctx sdk.Context, cb func(escrow types.VestingEscrow) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VestingEscrowsStorePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.VestingEscrow
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+		if cb(escrow) {
+			break
+		}
+	}
+}
+
+// GetAllVestingEscrows returns all vesting escrows
+func (k Keeper) GetAllVestingEscrows(ctx sdk.Context) []types.VestingEscrow {
+	escrows := []types.VestingEscrow{}
+	k.IterateVestingEscrows(ctx, func(escrow types.VestingEscrow) bool {
+		escrows = append(escrows, escrow)
+		return false
+	})
+	return escrows
+}
+
+// VestingEscrowBalances returns the unreleased amount of all vesting escrows held by the module account
+func (k Keeper) VestingEscrowBalances(ctx sdk.Context) sdk.Coins {
+	balances := sdk.Coins{}
+	k.IterateVestingEscrows(ctx, func(escrow types.VestingEscrow) bool {
+		balances = balances.Add(escrow.Unreleased()...)
+		return false
+	})
+	return balances
+}
+
+// sendClaimedCoins sends a claimed amount to an address. The vesting fraction of the amount vests in a
+// new continuous vesting account, or in a vesting escrow of the module when the account already exists.
+func (k Keeper) sendClaimedCoins(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) error {
+	params := k.GetParams(ctx)
+	liquid, vesting := params.SplitVesting(amount)
+	if vesting.IsZero() {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amount)
+	}
+
+	start := ctx.BlockTime()
+	end := start.Add(params.VestingDuration)
+	if acc := k.accountKeeper.GetAccount(ctx, addr); acc == nil {
+		// same as the alloc module vesting accounts
+		baseAccount, ok := k.accountKeeper.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount")
+		}
+		baseVestingAccount := vestingtypes.NewBaseVestingAccount(baseAccount, vesting.Sort(), end.Unix())
+		k.accountKeeper.SetAccount(ctx, vestingtypes.NewContinuousVestingAccountRaw(baseVestingAccount, start.Unix()))
+
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amount)
+		if err != nil {
+			return err
+		}
+	} else {
+		escrow := k.GetVestingEscrow(ctx, addr)
+		escrow.Schedules = append(escrow.Schedules, types.NewVestingSchedule(vesting, start, params.VestingDuration))
+		if err := k.SetVestingEscrow(ctx, escrow); err != nil {
+			return err
+		}
+		if !liquid.IsZero() {
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, liquid)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimVesting,
+			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, vesting.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", end.Unix())),
+		),
+	})
+	return nil
+}
+
+// ReleaseVesting sends the vested amount of the vesting escrow of an address
+func (k Keeper) ReleaseVesting(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	escrow := k.GetVestingEscrow(ctx, addr)
+	released := escrow.Release(ctx.BlockTime())
+	if released.IsZero() {
+		return released, nil
+	}
+	if err := k.SetVestingEscrow(ctx, escrow); err != nil {
+		return nil, err
+	}
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, released)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReleaseVesting,
+			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, released.String()),
+		),
+	})
+	return released, nil
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/public-awesome/stargaze/x/claim/keeper"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+func (suite *KeeperTestSuite) TestClaimIntoVesting() {
+	params := suite.app.ClaimKeeper.GetParams(suite.ctx)
+	params.VestingFraction = sdk.NewDecWithPrec(5, 1)
+	params.VestingDuration = time.Hour * 10
+	suite.app.ClaimKeeper.SetParams(suite.ctx, params)
+
+	newAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	existingAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, existingAddr))
+
+	records := []types.ClaimRecord{}
+	for _, addr := range []sdk.AccAddress{newAddr, existingAddr} {
+		records = append(records, types.ClaimRecord{
+			Address:                addr.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000)),
+			ActionCompleted:        []bool{false, false, false, false, false},
+		})
+	}
+	err := suite.app.ClaimKeeper.SetClaimRecords(suite.ctx, records)
+	suite.Require().NoError(err)
+
+	// a new account becomes a continuous vesting account
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, newAddr, types.ActionInitialClaim)
+	suite.Require().NoError(err)
+	acc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, newAddr).(*vestingtypes.ContinuousVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 100)), acc.OriginalVesting)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(params.VestingDuration).Unix(), acc.EndTime)
+	suite.Require().Equal(sdk.NewInt64Coin(types.DefaultClaimDenom, 200), suite.app.BankKeeper.GetBalance(suite.ctx, newAddr, types.DefaultClaimDenom))
+
+	// an existing account receives the liquid part and the rest vests in the module
+	_, err = suite.app.ClaimKeeper.ClaimCoinsForAction(suite.ctx, existingAddr, types.ActionInitialClaim)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(types.DefaultClaimDenom, 100), suite.app.BankKeeper.GetBalance(suite.ctx, existingAddr, types.DefaultClaimDenom))
+	escrow := suite.app.ClaimKeeper.GetVestingEscrow(suite.ctx, existingAddr)
+	suite.Require().Len(escrow.Schedules, 1)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 100)), escrow.Unreleased())
+
+	// escrowed funds are not part of the airdrop balance
+	suite.Require().Equal(sdk.NewInt64Coin(types.DefaultClaimDenom, 10000000-300-100), suite.app.ClaimKeeper.GetAirdropBalance(suite.ctx))
+	_, broken := keeper.ModuleAccountBalanceInvariant(suite.app.ClaimKeeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// half of the escrow is released halfway through the vesting duration
+	msgServer := keeper.NewMsgServerImpl(suite.app.ClaimKeeper)
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(params.VestingDuration / 2))
+	res, err := msgServer.ReleaseVesting(sdk.WrapSDKContext(ctx), types.NewMsgReleaseVesting(existingAddr.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 50)), res.ReleasedAmount)
+
+	query, err := suite.app.ClaimKeeper.VestingEscrow(sdk.WrapSDKContext(ctx), &types.QueryVestingEscrowRequest{Address: existingAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 50)), query.VestingEscrow.Unreleased())
+	suite.Require().True(query.Releasable.IsZero())
+
+	// the escrow is removed once fully released
+	ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(params.VestingDuration))
+	res, err = msgServer.ReleaseVesting(sdk.WrapSDKContext(ctx), types.NewMsgReleaseVesting(existingAddr.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 50)), res.ReleasedAmount)
+	suite.Require().Empty(suite.app.ClaimKeeper.GetAllVestingEscrows(ctx))
+	suite.Require().Equal(sdk.NewInt64Coin(types.DefaultClaimDenom, 200), suite.app.BankKeeper.GetBalance(ctx, existingAddr, types.DefaultClaimDenom))
+}
@@ -0,0 +1,53 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding claim type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.ClaimRecordsStorePrefix),
+			bytes.HasPrefix(kvA.Key, types.CampaignClaimRecordsStorePrefix):
+			var recordA, recordB types.ClaimRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key, types.AirdropStateKey):
+			var stateA, stateB types.AirdropState
+			cdc.MustUnmarshal(kvA.Value, &stateA)
+			cdc.MustUnmarshal(kvB.Value, &stateB)
+			return fmt.Sprintf("%v\n%v", stateA, stateB)
+
+		case bytes.HasPrefix(kvA.Key, types.CampaignsStorePrefix):
+			var campaignA, campaignB types.Campaign
+			cdc.MustUnmarshal(kvA.Value, &campaignA)
+			cdc.MustUnmarshal(kvB.Value, &campaignB)
+			return fmt.Sprintf("%v\n%v", campaignA, campaignB)
+
+		case bytes.Equal(kvA.Key, types.NextCampaignIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.VestingEscrowsStorePrefix):
+			var escrowA, escrowB types.VestingEscrow
+			cdc.MustUnmarshal(kvA.Value, &escrowA)
+			cdc.MustUnmarshal(kvB.Value, &escrowB)
+			return fmt.Sprintf("%v\n%v", escrowA, escrowB)
+
+		case bytes.HasPrefix(kvA.Key, types.FreeInitialClaimsStorePrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid claim key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -1,12 +1,88 @@
 package simulation
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/public-awesome/stargaze/x/claim/types"
 )
 
+// Simulation parameter constants
+const (
+	DurationUntilDecay = "duration_until_decay"
+	DurationOfDecay    = "duration_of_decay"
+	ClaimRecords       = "claim_records"
+)
+
+// GenDurationUntilDecay randomized DurationUntilDecay
+func GenDurationUntilDecay(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 60*60*24)) * time.Second
+}
+
+// GenDurationOfDecay randomized DurationOfDecay
+func GenDurationOfDecay(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 60*60*24*7)) * time.Second
+}
+
+// GenClaimRecords randomized claim records for about half of the accounts
+func GenClaimRecords(r *rand.Rand, accs []simtypes.Account, denom string) []types.ClaimRecord {
+	records := []types.ClaimRecord{}
+	for _, acc := range accs {
+		if r.Intn(2) == 0 {
+			continue
+		}
+		records = append(records, types.ClaimRecord{
+			Address:                acc.Address.String(),
+			InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(denom, int64(simtypes.RandIntBetween(r, 1, 1000000)))),
+			ActionCompleted:        make([]bool, len(types.Action_name)),
+		})
+	}
+	return records
+}
+
 // RandomizedGenState generates a random GenesisState  for claim
 func RandomizedGenState(simState *module.SimulationState) {
+	var durationUntilDecay, durationOfDecay time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DurationUntilDecay, &durationUntilDecay, simState.Rand,
+		func(r *rand.Rand) { durationUntilDecay = GenDurationUntilDecay(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DurationOfDecay, &durationOfDecay, simState.Rand,
+		func(r *rand.Rand) { durationOfDecay = GenDurationOfDecay(r) },
+	)
+	var claimRecords []types.ClaimRecord
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ClaimRecords, &claimRecords, simState.Rand,
+		func(r *rand.Rand) { claimRecords = GenClaimRecords(r, simState.Accounts, sdk.DefaultBondDenom) },
+	)
+
+	// the module account holds exactly the initial claimable amount of all records
+	moduleAccountBalance := sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())
+	for _, record := range claimRecords {
+		moduleAccountBalance = moduleAccountBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, record.InitialClaimableAmount.AmountOf(sdk.DefaultBondDenom)))
+	}
+
+	params := types.DefaultParams()
+	params.AirdropStartTime = simState.GenTimestamp
+	params.DurationUntilDecay = durationUntilDecay
+	params.DurationOfDecay = durationOfDecay
+	params.ClaimDenom = sdk.DefaultBondDenom
+
 	claimGenesis := types.DefaultGenesis()
+	claimGenesis.Params = params
+	claimGenesis.ClaimRecords = claimRecords
+	claimGenesis.ModuleAccountBalance = moduleAccountBalance
+
+	bz, err := json.MarshalIndent(&claimGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated claim parameters:\n%s\n", bz)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(claimGenesis)
 }
@@ -0,0 +1,195 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/public-awesome/stargaze/x/claim/keeper"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgInitialClaim = "op_weight_msg_initial_claim"
+	OpWeightMsgVote         = "op_weight_msg_claim_vote"
+	OpWeightMsgDelegate     = "op_weight_msg_claim_delegate"
+
+	DefaultWeightMsgInitialClaim = 50
+	DefaultWeightMsgVote         = 20
+	DefaultWeightMsgDelegate     = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, gk types.GovKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgInitialClaim, weightMsgVote, weightMsgDelegate int
+	appParams.GetOrGenerate(cdc, OpWeightMsgInitialClaim, &weightMsgInitialClaim, nil,
+		func(_ *rand.Rand) { weightMsgInitialClaim = DefaultWeightMsgInitialClaim },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgVote, &weightMsgVote, nil,
+		func(_ *rand.Rand) { weightMsgVote = DefaultWeightMsgVote },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegate, &weightMsgDelegate, nil,
+		func(_ *rand.Rand) { weightMsgDelegate = DefaultWeightMsgDelegate },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgInitialClaim,
+			SimulateMsgInitialClaim(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVote,
+			SimulateMsgVote(ak, bk, gk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegate,
+			SimulateMsgDelegate(ak, bk, sk, k),
+		),
+	}
+}
+
+// randomClaimer returns a random account with a claim record which didn't complete the action
+func randomClaimer(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, action types.Action) (simtypes.Account, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		record, err := k.GetClaimRecord(ctx, accs[i].Address)
+		if err != nil || record.Address == "" {
+			continue
+		}
+		if !record.ActionCompleted[action] {
+			return accs[i], true
+		}
+	}
+	return simtypes.Account{}, false
+}
+
+// SimulateMsgInitialClaim generates a MsgInitialClaim from an account with an unclaimed record
+func SimulateMsgInitialClaim(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParams(ctx).IsAirdropEnabled(ctx.BlockTime()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgInitialClaim, "airdrop not enabled"), nil, nil
+		}
+		simAccount, found := randomClaimer(r, ctx, k, accs, types.ActionInitialClaim)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgInitialClaim, "no unclaimed record"), nil, nil
+		}
+
+		msg := types.NewMsgInitialClaim(simAccount.Address.String())
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgVote generates a MsgVote on a proposal in voting period from an account
+// which didn't complete the vote action yet
+func SimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, gk types.GovKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		proposals := []govtypes.Proposal{}
+		for _, proposal := range gk.GetProposals(ctx) {
+			if proposal.Status == govtypes.StatusVotingPeriod {
+				proposals = append(proposals, proposal)
+			}
+		}
+		if len(proposals) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, govtypes.TypeMsgVote, "no proposal in voting period"), nil, nil
+		}
+		simAccount, found := randomClaimer(r, ctx, k, accs, types.ActionVote)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, govtypes.TypeMsgVote, "no unclaimed record"), nil, nil
+		}
+
+		proposal := proposals[r.Intn(len(proposals))]
+		option := govtypes.VoteOption(simtypes.RandIntBetween(r, int(govtypes.OptionYes), int(govtypes.OptionNoWithVeto)+1))
+		msg := govtypes.NewMsgVote(simAccount.Address, proposal.ProposalId, option)
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgDelegate generates a MsgDelegate to a random validator from an account
+// which didn't complete the delegate action yet
+func SimulateMsgDelegate(ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validators := []stakingtypes.Validator{}
+		for _, validator := range sk.GetAllValidators(ctx) {
+			if !validator.InvalidExRate() {
+				validators = append(validators, validator)
+			}
+		}
+		if len(validators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgDelegate, "no validator to delegate to"), nil, nil
+		}
+		simAccount, found := randomClaimer(r, ctx, k, accs, types.ActionDelegateStake)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgDelegate, "no unclaimed record"), nil, nil
+		}
+
+		denom := sk.BondDenom(ctx)
+		amount := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(denom)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgDelegate, "balance is zero"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgDelegate, "unable to generate positive amount"), nil, err
+		}
+
+		validator := validators[r.Intn(len(validators))]
+		coin := sdk.NewCoin(denom, amount)
+		msg := stakingtypes.NewMsgDelegate(simAccount.Address, validator.GetOperator(), coin)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(coin),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,29 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/public-awesome/stargaze/x/claim/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDurationUntilDecal),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenDurationUntilDecay(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDurationOfDecay),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenDurationOfDecay(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddClaimed adds an amount claimed for an action to the airdrop totals
+func (s *AirdropState) AddClaimed(action Action, amount sdk.Coins) {
+	for i, claimed := range s.Claimed {
+		if claimed.Action == action {
+			s.Claimed[i].Amount = claimed.Amount.Add(amount...)
+			return
+		}
+	}
+	s.Claimed = append(s.Claimed, ActionClaimed{Action: action, Amount: amount})
+}
+
+// ClaimedForAction returns the amount claimed from the airdrop for an action
+func (s AirdropState) ClaimedForAction(action Action) sdk.Coins {
+	for _, claimed := range s.Claimed {
+		if claimed.Action == action {
+			return claimed.Amount
+		}
+	}
+	return sdk.Coins{}
+}
@@ -0,0 +1,844 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stargaze/claim/v1beta1/airdrop_state.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AirdropStage int32
+
+const (
+	AirdropStageNotStarted AirdropStage = 0
+	AirdropStageActive     AirdropStage = 1
+	AirdropStageDecaying   AirdropStage = 2
+	AirdropStageEnded      AirdropStage = 3
+)
+
+var AirdropStage_name = map[int32]string{
+	0: "AirdropStageNotStarted",
+	1: "AirdropStageActive",
+	2: "AirdropStageDecaying",
+	3: "AirdropStageEnded",
+}
+
+var AirdropStage_value = map[string]int32{
+	"AirdropStageNotStarted": 0,
+	"AirdropStageActive":     1,
+	"AirdropStageDecaying":   2,
+	"AirdropStageEnded":      3,
+}
+
+func (x AirdropStage) String() string {
+	return proto.EnumName(AirdropStage_name, int32(x))
+}
+
+func (AirdropStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7fd82f03a9dd92c1, []int{0}
+}
+
+// AirdropState tracks the lifecycle of the airdrop
+type AirdropState struct {
+	Stage AirdropStage `protobuf:"varint,1,opt,name=stage,proto3,enum=publicawesome.stargaze.claim.v1beta1.AirdropStage" json:"stage,omitempty" yaml:"stage"`
+	// block height at which the airdrop ended
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// unclaimed amount swept to the community pool when the airdrop ended
+	SweptAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=swept_amount,json=sweptAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swept_amount" yaml:"swept_amount"`
+	// amount claimed from the airdrop for each action
+	Claimed []ActionClaimed `protobuf:"bytes,4,rep,name=claimed,proto3" json:"claimed" yaml:"claimed"`
+	// sum of the initial claimable amounts of all claim records, kept in sync
+	// with the claim records and reset when they are cleared
+	TotalAllocated github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_allocated,json=totalAllocated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_allocated" yaml:"total_allocated"`
+	// number of claim records with every weighted action completed, counted
+	// with the action weights at the time the record changed
+	FullyClaimedAddresses uint64 `protobuf:"varint,6,opt,name=fully_claimed_addresses,json=fullyClaimedAddresses,proto3" json:"fully_claimed_addresses,omitempty" yaml:"fully_claimed_addresses"`
+}
+
+func (m *AirdropState) Reset()         { *m = AirdropState{} }
+func (m *AirdropState) String() string { return proto.CompactTextString(m) }
+func (*AirdropState) ProtoMessage()    {}
+func (*AirdropState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fd82f03a9dd92c1, []int{0}
+}
+func (m *AirdropState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AirdropState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AirdropState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AirdropState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AirdropState.Merge(m, src)
+}
+func (m *AirdropState) XXX_Size() int {
+	return m.Size()
+}
+func (m *AirdropState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AirdropState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AirdropState proto.InternalMessageInfo
+
+func (m *AirdropState) GetStage() AirdropStage {
+	if m != nil {
+		return m.Stage
+	}
+	return AirdropStageNotStarted
+}
+
+func (m *AirdropState) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *AirdropState) GetSweptAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SweptAmount
+	}
+	return nil
+}
+
+func (m *AirdropState) GetClaimed() []ActionClaimed {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+func (m *AirdropState) GetTotalAllocated() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalAllocated
+	}
+	return nil
+}
+
+func (m *AirdropState) GetFullyClaimedAddresses() uint64 {
+	if m != nil {
+		return m.FullyClaimedAddresses
+	}
+	return 0
+}
+
+// ActionClaimed is the total amount claimed for an action
+type ActionClaimed struct {
+	Action Action                                   `protobuf:"varint,1,opt,name=action,proto3,enum=publicawesome.stargaze.claim.v1beta1.Action" json:"action,omitempty" yaml:"action"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *ActionClaimed) Reset()         { *m = ActionClaimed{} }
+func (m *ActionClaimed) String() string { return proto.CompactTextString(m) }
+func (*ActionClaimed) ProtoMessage()    {}
+func (*ActionClaimed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fd82f03a9dd92c1, []int{1}
+}
+func (m *ActionClaimed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionClaimed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionClaimed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionClaimed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionClaimed.Merge(m, src)
+}
+func (m *ActionClaimed) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionClaimed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionClaimed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionClaimed proto.InternalMessageInfo
+
+func (m *ActionClaimed) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return ActionInitialClaim
+}
+
+func (m *ActionClaimed) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("publicawesome.stargaze.claim.v1beta1.AirdropStage", AirdropStage_name, AirdropStage_value)
+	proto.RegisterType((*AirdropState)(nil), "publicawesome.stargaze.claim.v1beta1.AirdropState")
+	proto.RegisterType((*ActionClaimed)(nil), "publicawesome.stargaze.claim.v1beta1.ActionClaimed")
+}
+
+func init() {
+	proto.RegisterFile("stargaze/claim/v1beta1/airdrop_state.proto", fileDescriptor_7fd82f03a9dd92c1)
+}
+
+var fileDescriptor_7fd82f03a9dd92c1 = []byte{
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0x26, 0x0d, 0xe2, 0xfa, 0x87, 0xf4, 0x68, 0x83, 0xc9, 0x60, 0x47, 0x16, 0x43,
+	0xa8, 0xa8, 0x4d, 0x5b, 0x26, 0xb6, 0xb8, 0x20, 0x50, 0x07, 0x06, 0x77, 0x40, 0xea, 0x62, 0x5d,
+	0x7c, 0x2f, 0xae, 0x85, 0xed, 0x8b, 0x7c, 0x97, 0x96, 0xb0, 0x23, 0x31, 0x21, 0xbe, 0x03, 0x1b,
+	0x9f, 0xa4, 0x63, 0x47, 0x26, 0x83, 0xda, 0x6f, 0x90, 0x99, 0x01, 0xe5, 0xee, 0x92, 0x1a, 0xa4,
+	0xaa, 0x2d, 0x93, 0xef, 0xee, 0xbd, 0xdf, 0x73, 0xcf, 0xfb, 0xe8, 0x7c, 0x68, 0x93, 0x0b, 0x52,
+	0xc4, 0xe4, 0x23, 0x78, 0x51, 0x4a, 0x92, 0xcc, 0x3b, 0xde, 0x1e, 0x80, 0x20, 0xdb, 0x1e, 0x49,
+	0x0a, 0x5a, 0xb0, 0x61, 0xc8, 0x05, 0x11, 0xe0, 0x0e, 0x0b, 0x26, 0x18, 0x7e, 0x34, 0x1c, 0x0d,
+	0xd2, 0x24, 0x22, 0x27, 0xc0, 0x59, 0x06, 0xee, 0x8c, 0x74, 0x25, 0xe9, 0x6a, 0xb2, 0xb3, 0x1e,
+	0xb3, 0x98, 0x49, 0xc0, 0x9b, 0x8e, 0x14, 0xdb, 0xb1, 0x22, 0xc6, 0x33, 0xc6, 0xbd, 0x01, 0xe1,
+	0x30, 0x3f, 0x24, 0x62, 0x49, 0xae, 0xeb, 0x8f, 0xaf, 0xf0, 0x21, 0x67, 0x61, 0x01, 0x11, 0x2b,
+	0xa8, 0xda, 0xea, 0xfc, 0x6e, 0xa0, 0xe5, 0xbe, 0xb2, 0x77, 0x30, 0x75, 0x87, 0x0f, 0xd1, 0x22,
+	0x17, 0x24, 0x06, 0xd3, 0xe8, 0x1a, 0xbd, 0xd5, 0x9d, 0x1d, 0xf7, 0x26, 0x3e, 0xdd, 0x4b, 0x89,
+	0x18, 0xfc, 0xd6, 0xa4, 0xb4, 0x97, 0xc7, 0x24, 0x4b, 0x9f, 0x3b, 0x52, 0xca, 0x09, 0x94, 0x24,
+	0x7e, 0x86, 0x10, 0xe4, 0x34, 0x3c, 0x82, 0x24, 0x3e, 0x12, 0xe6, 0x42, 0xd7, 0xe8, 0xd5, 0xfd,
+	0x8d, 0x49, 0x69, 0xaf, 0xa9, 0xcd, 0x97, 0x35, 0x27, 0xb8, 0x0b, 0x39, 0x7d, 0x2d, 0xc7, 0xf8,
+	0x93, 0x81, 0x96, 0xf9, 0x09, 0x0c, 0x45, 0x48, 0x32, 0x36, 0xca, 0x85, 0x59, 0xef, 0xd6, 0x7b,
+	0x4b, 0x3b, 0x0f, 0x5d, 0x95, 0x82, 0x3b, 0x4d, 0x61, 0x6e, 0x64, 0x8f, 0x25, 0xb9, 0xff, 0xea,
+	0xb4, 0xb4, 0x6b, 0x93, 0xd2, 0xbe, 0xaf, 0x4d, 0x54, 0x60, 0xe7, 0xfb, 0x4f, 0xbb, 0x17, 0x27,
+	0xe2, 0x68, 0x34, 0x70, 0x23, 0x96, 0x79, 0x3a, 0x49, 0xf5, 0xd9, 0xe2, 0xf4, 0xbd, 0x27, 0xc6,
+	0x43, 0xe0, 0x52, 0x87, 0x07, 0x4b, 0x12, 0xed, 0x4b, 0x12, 0x03, 0xba, 0x23, 0x9b, 0x06, 0x6a,
+	0x36, 0xa4, 0x83, 0xdd, 0x1b, 0x66, 0x13, 0x89, 0x84, 0xe5, 0x7b, 0x0a, 0xf5, 0xdb, 0xda, 0xdb,
+	0xaa, 0xf2, 0xa6, 0x15, 0x9d, 0x60, 0xa6, 0x8d, 0xbf, 0x18, 0xe8, 0x9e, 0x60, 0x82, 0xa4, 0x21,
+	0x49, 0x53, 0x16, 0x11, 0x01, 0xd4, 0x5c, 0xbc, 0xae, 0xe3, 0x7d, 0xad, 0xda, 0x56, 0xaa, 0xff,
+	0xf0, 0xb7, 0x6b, 0x7a, 0x55, 0xd2, 0xfd, 0x19, 0x8c, 0x0f, 0xd1, 0x83, 0x77, 0xa3, 0x34, 0x1d,
+	0x87, 0xda, 0x61, 0x48, 0x28, 0x2d, 0x80, 0x73, 0xe0, 0x66, 0xb3, 0x6b, 0xf4, 0x1a, 0xbe, 0x33,
+	0x29, 0x6d, 0x4b, 0x1d, 0x7c, 0xc5, 0x46, 0x27, 0xd8, 0x90, 0x15, 0x1d, 0x42, 0x7f, 0xbe, 0x5e,
+	0x1a, 0x68, 0xe5, 0xaf, 0x7c, 0xf0, 0x5b, 0xd4, 0x24, 0x72, 0x41, 0x5f, 0xc0, 0x27, 0xb7, 0x09,
+	0xd9, 0x5f, 0x9b, 0x94, 0xf6, 0x8a, 0xb2, 0xa2, 0x54, 0x9c, 0x40, 0xcb, 0x61, 0x81, 0x9a, 0xfa,
+	0xfe, 0x2c, 0x5c, 0x97, 0x66, 0x5f, 0xa7, 0x39, 0x53, 0xfa, 0x8f, 0x9b, 0xa3, 0xcf, 0xda, 0x1c,
+	0x57, 0x7f, 0xaf, 0x18, 0x70, 0x07, 0xb5, 0xab, 0xf3, 0x37, 0x4c, 0x1c, 0x08, 0x52, 0x08, 0xa0,
+	0xad, 0x1a, 0x6e, 0x23, 0x5c, 0xad, 0x4d, 0x5b, 0x3a, 0x86, 0x96, 0x81, 0x4d, 0xb4, 0x5e, 0x5d,
+	0x7f, 0x01, 0x11, 0x19, 0x27, 0x79, 0xdc, 0x5a, 0xc0, 0x1b, 0x68, 0xad, 0x5a, 0x79, 0x99, 0x53,
+	0xa0, 0xad, 0x7a, 0xa7, 0xf1, 0xf9, 0x9b, 0x55, 0xf3, 0xf7, 0x4f, 0xcf, 0x2d, 0xe3, 0xec, 0xdc,
+	0x32, 0x7e, 0x9d, 0x5b, 0xc6, 0xd7, 0x0b, 0xab, 0x76, 0x76, 0x61, 0xd5, 0x7e, 0x5c, 0x58, 0xb5,
+	0xc3, 0xa7, 0x95, 0x36, 0x54, 0xba, 0x5b, 0x3a, 0x5e, 0x6f, 0xfe, 0x72, 0x7c, 0xd0, 0x6f, 0x87,
+	0x6c, 0x6a, 0xd0, 0x94, 0xaf, 0xc5, 0xee, 0x9f, 0x01, 0x00, 0xdc, 0x9f, 0x0e, 0xaa, 0xe2, 0x04,
+	0x00, 0x00,
+}
+
+func (m *AirdropState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirdropState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirdropState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FullyClaimedAddresses != 0 {
+		i = encodeVarintAirdropState(dAtA, i, uint64(m.FullyClaimedAddresses))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TotalAllocated) > 0 {
+		for iNdEx := len(m.TotalAllocated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAllocated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAirdropState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAirdropState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SweptAmount) > 0 {
+		for iNdEx := len(m.SweptAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SweptAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAirdropState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintAirdropState(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Stage != 0 {
+		i = encodeVarintAirdropState(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionClaimed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionClaimed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionClaimed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAirdropState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintAirdropState(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAirdropState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAirdropState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AirdropState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stage != 0 {
+		n += 1 + sovAirdropState(uint64(m.Stage))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovAirdropState(uint64(m.EndHeight))
+	}
+	if len(m.SweptAmount) > 0 {
+		for _, e := range m.SweptAmount {
+			l = e.Size()
+			n += 1 + l + sovAirdropState(uint64(l))
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovAirdropState(uint64(l))
+		}
+	}
+	if len(m.TotalAllocated) > 0 {
+		for _, e := range m.TotalAllocated {
+			l = e.Size()
+			n += 1 + l + sovAirdropState(uint64(l))
+		}
+	}
+	if m.FullyClaimedAddresses != 0 {
+		n += 1 + sovAirdropState(uint64(m.FullyClaimedAddresses))
+	}
+	return n
+}
+
+func (m *ActionClaimed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovAirdropState(uint64(m.Action))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovAirdropState(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAirdropState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAirdropState(x uint64) (n int) {
+	return sovAirdropState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AirdropState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdropState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AirdropState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AirdropState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdropState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= AirdropStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdropState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweptAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdropState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAirdropState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdropState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SweptAmount = append(m.SweptAmount, types.Coin{})
+			if err := m.SweptAmount[len(m.SweptAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdropState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAirdropState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdropState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, ActionClaimed{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdropState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAirdropState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdropState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAllocated = append(m.TotalAllocated, types.Coin{})
+			if err := m.TotalAllocated[len(m.TotalAllocated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullyClaimedAddresses", wireType)
+			}
+			m.FullyClaimedAddresses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdropState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FullyClaimedAddresses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdropState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdropState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionClaimed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdropState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionClaimed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionClaimed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdropState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdropState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAirdropState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdropState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdropState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdropState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAirdropState(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAirdropState
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAirdropState
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAirdropState
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAirdropState
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAirdropState
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAirdropState
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAirdropState        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAirdropState          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAirdropState = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,71 @@
+package types
+
+import (
+	fmt "fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndTime returns the time after which nothing is claimable from the campaign
+func (c Campaign) EndTime() time.Time {
+	return c.StartTime.Add(c.DurationUntilDecay + c.DurationOfDecay)
+}
+
+// IsActive returns true if the campaign can be claimed from at the given time
+func (c Campaign) IsActive(t time.Time) bool {
+	return !c.Ended && !t.Before(c.StartTime) && !t.After(c.EndTime())
+}
+
+// IsOver returns true if the campaign decay is over at the given time
+func (c Campaign) IsOver(t time.Time) bool {
+	return t.After(c.EndTime())
+}
+
+// EffectiveActionWeights returns the action weight table, falling back to an even split
+// between all actions when no table is set
+func (c Campaign) EffectiveActionWeights() []ActionWeight {
+	if len(c.ActionWeights) == 0 {
+		return DefaultActionWeights()
+	}
+	return c.ActionWeights
+}
+
+// Validate performs stateless validation of the campaign schedule
+func (c Campaign) Validate() error {
+	if err := validateCampaignSchedule(c.Name, c.Denom, c.DurationUntilDecay, c.DurationOfDecay, c.DecayFunction, c.ActionWeights); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Funder); err != nil {
+		return fmt.Errorf("invalid campaign funder %s: %w", c.Funder, err)
+	}
+	if err := c.Balance.Validate(); err != nil {
+		return err
+	}
+	if c.Balance.Denom != c.Denom {
+		return fmt.Errorf("campaign balance denom %s does not match campaign denom %s", c.Balance.Denom, c.Denom)
+	}
+	if c.Ended && !c.Balance.IsZero() {
+		return fmt.Errorf("ended campaign %d has a balance of %s", c.Id, c.Balance)
+	}
+	return nil
+}
+
+func validateCampaignSchedule(name, denom string, untilDecay, ofDecay time.Duration, decay DecayFunction, weights []ActionWeight) error {
+	if name == "" {
+		return fmt.Errorf("campaign name cannot be empty")
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if err := validateDuration(untilDecay); err != nil {
+		return err
+	}
+	if err := validateDuration(ofDecay); err != nil {
+		return err
+	}
+	if err := validateDecayFunction(decay); err != nil {
+		return err
+	}
+	return validateActionWeights(weights)
+}
@@ -0,0 +1,1072 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stargaze/claim/v1beta1/campaign.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Campaign is an airdrop running next to the global airdrop with its own
+// schedule, actions and funds
+type Campaign struct {
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// denom of claimable asset
+	Denom              string        `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	StartTime          time.Time     `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	DurationUntilDecay time.Duration `protobuf:"bytes,5,opt,name=duration_until_decay,json=durationUntilDecay,proto3,stdduration" json:"duration_until_decay,omitempty" yaml:"duration_until_decay"`
+	DurationOfDecay    time.Duration `protobuf:"bytes,6,opt,name=duration_of_decay,json=durationOfDecay,proto3,stdduration" json:"duration_of_decay,omitempty" yaml:"duration_of_decay"`
+	DecayFunction      DecayFunction `protobuf:"bytes,7,opt,name=decay_function,json=decayFunction,proto3" json:"decay_function" yaml:"decay_function"`
+	// actions rewarded by the campaign and their share, actions not in the
+	// table are not rewarded. an empty table splits evenly between all actions.
+	ActionWeights []ActionWeight `protobuf:"bytes,8,rep,name=action_weights,json=actionWeights,proto3" json:"action_weights" yaml:"action_weights"`
+	// account that funded the campaign
+	Funder string `protobuf:"bytes,9,opt,name=funder,proto3" json:"funder,omitempty" yaml:"funder"`
+	// unclaimed funds held by the claim module account for the campaign
+	Balance types.Coin `protobuf:"bytes,10,opt,name=balance,proto3" json:"balance" yaml:"balance"`
+	// true once the campaign balance has been swept to the community pool
+	Ended bool `protobuf:"varint,11,opt,name=ended,proto3" json:"ended,omitempty" yaml:"ended"`
+}
+
+func (m *Campaign) Reset()         { *m = Campaign{} }
+func (m *Campaign) String() string { return proto.CompactTextString(m) }
+func (*Campaign) ProtoMessage()    {}
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b043c6a8fc75c919, []int{0}
+}
+func (m *Campaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Campaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Campaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Campaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Campaign.Merge(m, src)
+}
+func (m *Campaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *Campaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_Campaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Campaign proto.InternalMessageInfo
+
+func (m *Campaign) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Campaign) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Campaign) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Campaign) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Campaign) GetDurationUntilDecay() time.Duration {
+	if m != nil {
+		return m.DurationUntilDecay
+	}
+	return 0
+}
+
+func (m *Campaign) GetDurationOfDecay() time.Duration {
+	if m != nil {
+		return m.DurationOfDecay
+	}
+	return 0
+}
+
+func (m *Campaign) GetDecayFunction() DecayFunction {
+	if m != nil {
+		return m.DecayFunction
+	}
+	return DecayFunction{}
+}
+
+func (m *Campaign) GetActionWeights() []ActionWeight {
+	if m != nil {
+		return m.ActionWeights
+	}
+	return nil
+}
+
+func (m *Campaign) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *Campaign) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+func (m *Campaign) GetEnded() bool {
+	if m != nil {
+		return m.Ended
+	}
+	return false
+}
+
+// CampaignClaimRecord is a claim record of a campaign
+type CampaignClaimRecord struct {
+	CampaignId  uint64      `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty" yaml:"campaign_id"`
+	ClaimRecord ClaimRecord `protobuf:"bytes,2,opt,name=claim_record,json=claimRecord,proto3" json:"claim_record" yaml:"claim_record"`
+}
+
+func (m *CampaignClaimRecord) Reset()         { *m = CampaignClaimRecord{} }
+func (m *CampaignClaimRecord) String() string { return proto.CompactTextString(m) }
+func (*CampaignClaimRecord) ProtoMessage()    {}
+func (*CampaignClaimRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b043c6a8fc75c919, []int{1}
+}
+func (m *CampaignClaimRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CampaignClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CampaignClaimRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CampaignClaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CampaignClaimRecord.Merge(m, src)
+}
+func (m *CampaignClaimRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CampaignClaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CampaignClaimRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CampaignClaimRecord proto.InternalMessageInfo
+
+func (m *CampaignClaimRecord) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *CampaignClaimRecord) GetClaimRecord() ClaimRecord {
+	if m != nil {
+		return m.ClaimRecord
+	}
+	return ClaimRecord{}
+}
+
+func init() {
+	proto.RegisterType((*Campaign)(nil), "publicawesome.stargaze.claim.v1beta1.Campaign")
+	proto.RegisterType((*CampaignClaimRecord)(nil), "publicawesome.stargaze.claim.v1beta1.CampaignClaimRecord")
+}
+
+func init() {
+	proto.RegisterFile("stargaze/claim/v1beta1/campaign.proto", fileDescriptor_b043c6a8fc75c919)
+}
+
+var fileDescriptor_b043c6a8fc75c919 = []byte{
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4b, 0x6f, 0xd3, 0x4c,
+	0x14, 0x8d, 0xfb, 0xce, 0xa4, 0x69, 0xbf, 0x4e, 0xfb, 0x55, 0x26, 0x55, 0xed, 0x68, 0x0a, 0x28,
+	0x95, 0xc0, 0xa6, 0xe9, 0x02, 0x89, 0x1d, 0x49, 0x85, 0x54, 0x58, 0x20, 0x59, 0x20, 0x10, 0x9b,
+	0x68, 0x62, 0x4f, 0xdc, 0x91, 0x62, 0x4f, 0xf0, 0x83, 0x36, 0xfc, 0x04, 0xd8, 0x74, 0x85, 0xf8,
+	0x3b, 0xec, 0xba, 0xec, 0x92, 0x95, 0x41, 0xed, 0x8e, 0xa5, 0x7f, 0x01, 0x9a, 0x87, 0x53, 0xf7,
+	0x25, 0xba, 0xf3, 0xdc, 0x7b, 0xce, 0xb9, 0xe7, 0xde, 0xf1, 0x1d, 0xf0, 0x20, 0x4e, 0x70, 0xe4,
+	0xe3, 0xcf, 0xc4, 0x76, 0x87, 0x98, 0x06, 0xf6, 0xa7, 0x9d, 0x3e, 0x49, 0xf0, 0x8e, 0xed, 0xe2,
+	0x60, 0x84, 0xa9, 0x1f, 0x5a, 0xa3, 0x88, 0x25, 0x0c, 0xde, 0x1f, 0xa5, 0xfd, 0x21, 0x75, 0xf1,
+	0x21, 0x89, 0x59, 0x40, 0xac, 0x82, 0x64, 0x09, 0x92, 0xa5, 0x48, 0x8d, 0x35, 0x9f, 0xf9, 0x4c,
+	0x10, 0x6c, 0xfe, 0x25, 0xb9, 0x0d, 0xc3, 0x67, 0xcc, 0x1f, 0x12, 0x5b, 0x9c, 0xfa, 0xe9, 0xc0,
+	0xf6, 0xd2, 0x08, 0x27, 0x94, 0x29, 0xed, 0x86, 0x79, 0x35, 0x9f, 0xd0, 0x80, 0xc4, 0x09, 0x0e,
+	0x46, 0x85, 0x80, 0xcb, 0xe2, 0x80, 0xc5, 0x76, 0x1f, 0xc7, 0xe4, 0xc2, 0x20, 0xa3, 0x85, 0xc0,
+	0xf6, 0x6d, 0x3d, 0xf0, 0x53, 0x2f, 0x22, 0x2e, 0x8b, 0x3c, 0x05, 0xdd, 0xba, 0x05, 0x3a, 0xc2,
+	0x11, 0x0e, 0x62, 0x09, 0x42, 0x5f, 0xe7, 0xc1, 0x42, 0x57, 0xf5, 0x0f, 0x37, 0xc1, 0x14, 0xf5,
+	0x74, 0xad, 0xa9, 0xb5, 0x66, 0x3a, 0xf5, 0x3c, 0x33, 0xab, 0x63, 0x1c, 0x0c, 0x9f, 0x21, 0xea,
+	0x21, 0x67, 0x8a, 0x7a, 0x70, 0x0b, 0xcc, 0x84, 0x38, 0x20, 0xfa, 0x54, 0x53, 0x6b, 0x55, 0x3b,
+	0xcb, 0x79, 0x66, 0xd6, 0x24, 0x80, 0x47, 0x91, 0x23, 0x92, 0xf0, 0x21, 0x98, 0xf5, 0x48, 0xc8,
+	0x02, 0x7d, 0x5a, 0xa0, 0xfe, 0xcb, 0x33, 0x73, 0x51, 0xa2, 0x44, 0x18, 0x39, 0x32, 0x0d, 0xdf,
+	0x03, 0xc0, 0xfd, 0x25, 0x3d, 0x3e, 0x01, 0x7d, 0xa6, 0xa9, 0xb5, 0x6a, 0xed, 0x86, 0x25, 0xc7,
+	0x63, 0x15, 0xe3, 0xb1, 0xde, 0x14, 0xe3, 0xe9, 0x6c, 0x9e, 0x64, 0x66, 0x25, 0xcf, 0xcc, 0x15,
+	0x29, 0x76, 0xc1, 0x45, 0xc7, 0xbf, 0x4c, 0xcd, 0xa9, 0x8a, 0x00, 0x87, 0xc3, 0x6f, 0x1a, 0x58,
+	0x2b, 0xc6, 0xde, 0x4b, 0xc3, 0x84, 0x0e, 0x7b, 0x1e, 0x71, 0xf1, 0x58, 0x9f, 0x15, 0x45, 0xee,
+	0x5d, 0x2b, 0xb2, 0xa7, 0xc0, 0x9d, 0x7d, 0x5e, 0xe3, 0x4f, 0x66, 0x1a, 0x37, 0xd1, 0x1f, 0xb1,
+	0x80, 0x26, 0x24, 0x18, 0x25, 0xe3, 0x3c, 0x33, 0x37, 0x54, 0x4b, 0x37, 0xe0, 0xd0, 0x77, 0xee,
+	0x07, 0x16, 0xa9, 0xb7, 0x3c, 0xb3, 0xc7, 0x13, 0xf0, 0x8b, 0x06, 0x56, 0x26, 0x0c, 0x36, 0x50,
+	0xae, 0xe6, 0xfe, 0xe5, 0xaa, 0xab, 0x5c, 0x6d, 0x5c, 0xe3, 0x5e, 0xb2, 0xa4, 0x5f, 0xb1, 0xc4,
+	0x06, 0x65, 0x3f, 0xcb, 0x45, 0xfc, 0xf5, 0x40, 0x9a, 0x19, 0x83, 0x25, 0x91, 0xee, 0x0d, 0xd2,
+	0xd0, 0xe5, 0x09, 0x7d, 0x5e, 0x18, 0xd9, 0xb5, 0xee, 0xf2, 0xfb, 0x5b, 0x42, 0xe4, 0x85, 0xa2,
+	0x4e, 0x2e, 0xe7, 0xff, 0xe2, 0xa6, 0xcb, 0xc2, 0xc8, 0xa9, 0x7b, 0x65, 0x34, 0x3c, 0x02, 0x4b,
+	0x58, 0x7c, 0xf5, 0x0e, 0x09, 0xf5, 0x0f, 0x92, 0x58, 0x5f, 0x68, 0x4e, 0xb7, 0x6a, 0xed, 0xf6,
+	0xdd, 0x4a, 0x3f, 0x17, 0xdc, 0x77, 0x82, 0x7a, 0xb5, 0xf2, 0x65, 0x5d, 0xe4, 0xd4, 0x71, 0x09,
+	0x1c, 0xc3, 0x6d, 0x30, 0x37, 0x48, 0x43, 0x8f, 0x44, 0x7a, 0x55, 0xfc, 0x9d, 0x2b, 0x79, 0x66,
+	0xd6, 0x25, 0x53, 0xc6, 0x91, 0xa3, 0x00, 0xf0, 0x15, 0x98, 0xef, 0xe3, 0x21, 0x0e, 0x5d, 0xa2,
+	0x03, 0x75, 0x43, 0x72, 0x35, 0x2d, 0xbe, 0x9a, 0x13, 0x33, 0x5d, 0x46, 0xc3, 0xce, 0xba, 0x32,
+	0xb1, 0x24, 0xa5, 0x14, 0x0f, 0x39, 0x85, 0x02, 0x5f, 0x0a, 0x12, 0x7a, 0xc4, 0xd3, 0x6b, 0x4d,
+	0xad, 0xb5, 0x50, 0x5e, 0x0a, 0x11, 0x46, 0x8e, 0x4c, 0xa3, 0x1f, 0x1a, 0x58, 0x2d, 0xb6, 0xb1,
+	0xcb, 0x9b, 0x76, 0xc4, 0x42, 0xc3, 0xa7, 0xa0, 0x56, 0x3c, 0x52, 0xbd, 0xc9, 0x86, 0xae, 0xe7,
+	0x99, 0x09, 0xa5, 0x4a, 0x29, 0x89, 0x1c, 0x50, 0x9c, 0xf6, 0x3d, 0xf8, 0x11, 0x2c, 0x96, 0x5f,
+	0x06, 0xb1, 0xba, 0xb5, 0xf6, 0xce, 0xdd, 0x06, 0x5d, 0x72, 0xd0, 0xd9, 0x50, 0x2d, 0xae, 0xaa,
+	0x82, 0x25, 0x51, 0xe4, 0xd4, 0xdc, 0x12, 0xf2, 0xe5, 0xc9, 0x99, 0xa1, 0x9d, 0x9e, 0x19, 0xda,
+	0xef, 0x33, 0x43, 0x3b, 0x3e, 0x37, 0x2a, 0xa7, 0xe7, 0x46, 0xe5, 0xe7, 0xb9, 0x51, 0xf9, 0xf0,
+	0xc4, 0xa7, 0xc9, 0x41, 0xda, 0xb7, 0x5c, 0x16, 0xd8, 0xd2, 0xc0, 0x63, 0xe5, 0xc0, 0x9e, 0x3c,
+	0x55, 0x47, 0xea, 0xb1, 0x4a, 0xc6, 0x23, 0x12, 0xf7, 0xe7, 0xc4, 0x36, 0xec, 0xfe, 0x1d, 0x00,
+	0x12, 0x40, 0x60, 0x81, 0xba, 0x05, 0x00, 0x00,
+}
+
+func (m *Campaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Campaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Campaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ended {
+		i--
+		if m.Ended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCampaign(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintCampaign(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ActionWeights) > 0 {
+		for iNdEx := len(m.ActionWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActionWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCampaign(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.DecayFunction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCampaign(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintCampaign(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintCampaign(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintCampaign(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCampaign(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCampaign(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCampaign(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CampaignClaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CampaignClaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CampaignClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCampaign(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CampaignId != 0 {
+		i = encodeVarintCampaign(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCampaign(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCampaign(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Campaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCampaign(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCampaign(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCampaign(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovCampaign(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay)
+	n += 1 + l + sovCampaign(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay)
+	n += 1 + l + sovCampaign(uint64(l))
+	l = m.DecayFunction.Size()
+	n += 1 + l + sovCampaign(uint64(l))
+	if len(m.ActionWeights) > 0 {
+		for _, e := range m.ActionWeights {
+			l = e.Size()
+			n += 1 + l + sovCampaign(uint64(l))
+		}
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovCampaign(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovCampaign(uint64(l))
+	if m.Ended {
+		n += 2
+	}
+	return n
+}
+
+func (m *CampaignClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovCampaign(uint64(m.CampaignId))
+	}
+	l = m.ClaimRecord.Size()
+	n += 1 + l + sovCampaign(uint64(l))
+	return n
+}
+
+func sovCampaign(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCampaign(x uint64) (n int) {
+	return sovCampaign(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Campaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaign
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Campaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Campaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationUntilDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DurationUntilDecay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationOfDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DurationOfDecay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFunction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFunction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionWeights = append(m.ActionWeights, ActionWeight{})
+			if err := m.ActionWeights[len(m.ActionWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ended = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaign(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CampaignClaimRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaign
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CampaignClaimRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CampaignClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaign(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCampaign(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCampaign
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCampaign
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCampaign
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCampaign
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCampaign        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCampaign          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCampaign = fmt.Errorf("proto: unexpected end of group")
+)
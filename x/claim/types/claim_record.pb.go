@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -67,6 +71,10 @@ type ClaimRecord struct {
 	// true if action is completed
 	// index of bool in array refers to action enum #
 	ActionCompleted []bool `protobuf:"varint,4,rep,packed,name=action_completed,json=actionCompleted,proto3" json:"action_completed,omitempty" yaml:"action_completed"`
+	// true if all rewarded actions were completed when the airdrop record was
+	// last stored. the airdrop state counts fully claimed addresses by this flag,
+	// so later action weight changes don't make the count drift.
+	FullyClaimed bool `protobuf:"varint,6,opt,name=fully_claimed,json=fullyClaimed,proto3" json:"fully_claimed,omitempty" yaml:"fully_claimed"`
 }
 
 func (m *ClaimRecord) Reset()         { *m = ClaimRecord{} }
@@ -123,9 +131,151 @@ func (m *ClaimRecord) GetActionCompleted() []bool {
 	return nil
 }
 
+func (m *ClaimRecord) GetFullyClaimed() bool {
+	if m != nil {
+		return m.FullyClaimed
+	}
+	return false
+}
+
+// ActionClaim is the amount received for an action and when it was claimed
+type ActionClaim struct {
+	Action Action                                   `protobuf:"varint,1,opt,name=action,proto3,enum=publicawesome.stargaze.claim.v1beta1.Action" json:"action,omitempty" yaml:"action"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	Height int64                                    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Time   time.Time                                `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+}
+
+func (m *ActionClaim) Reset()         { *m = ActionClaim{} }
+func (m *ActionClaim) String() string { return proto.CompactTextString(m) }
+func (*ActionClaim) ProtoMessage()    {}
+func (*ActionClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a75b9157744df4f, []int{1}
+}
+func (m *ActionClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionClaim.Merge(m, src)
+}
+func (m *ActionClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionClaim proto.InternalMessageInfo
+
+func (m *ActionClaim) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return ActionInitialClaim
+}
+
+func (m *ActionClaim) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ActionClaim) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ActionClaim) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// ClaimHistoryRecord is a claim of an address from the airdrop or a campaign.
+// The claim history is never cleared or transferred, so it survives the end of
+// the airdrop and of campaigns.
+type ClaimHistoryRecord struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// campaign the claim was made from, 0 for the airdrop
+	CampaignId uint64      `protobuf:"varint,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty" yaml:"campaign_id"`
+	Claim      ActionClaim `protobuf:"bytes,3,opt,name=claim,proto3" json:"claim" yaml:"claim"`
+}
+
+func (m *ClaimHistoryRecord) Reset()         { *m = ClaimHistoryRecord{} }
+func (m *ClaimHistoryRecord) String() string { return proto.CompactTextString(m) }
+func (*ClaimHistoryRecord) ProtoMessage()    {}
+func (*ClaimHistoryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a75b9157744df4f, []int{2}
+}
+func (m *ClaimHistoryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimHistoryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimHistoryRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimHistoryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimHistoryRecord.Merge(m, src)
+}
+func (m *ClaimHistoryRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimHistoryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimHistoryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimHistoryRecord proto.InternalMessageInfo
+
+func (m *ClaimHistoryRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ClaimHistoryRecord) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *ClaimHistoryRecord) GetClaim() ActionClaim {
+	if m != nil {
+		return m.Claim
+	}
+	return ActionClaim{}
+}
+
 func init() {
 	proto.RegisterEnum("publicawesome.stargaze.claim.v1beta1.Action", Action_name, Action_value)
 	proto.RegisterType((*ClaimRecord)(nil), "publicawesome.stargaze.claim.v1beta1.ClaimRecord")
+	proto.RegisterType((*ActionClaim)(nil), "publicawesome.stargaze.claim.v1beta1.ActionClaim")
+	proto.RegisterType((*ClaimHistoryRecord)(nil), "publicawesome.stargaze.claim.v1beta1.ClaimHistoryRecord")
 }
 
 func init() {
@@ -133,35 +283,50 @@ func init() {
 }
 
 var fileDescriptor_8a75b9157744df4f = []byte{
-	// 448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x93, 0xa8, 0xc0, 0x56, 0x14, 0xb3, 0x54, 0xad, 0xc9, 0xc1, 0x8e, 0x2c, 0x0e, 0x01,
-	0x51, 0x9b, 0xc2, 0x8d, 0x5b, 0x1d, 0x84, 0x44, 0x25, 0x38, 0x24, 0x15, 0x07, 0x2e, 0xd1, 0xda,
-	0x1e, 0x99, 0x55, 0x6c, 0x4f, 0xe4, 0x5d, 0x03, 0x41, 0x7c, 0x00, 0x47, 0xfe, 0x81, 0x0b, 0xe2,
-	0xc8, 0x57, 0xf4, 0xd8, 0x23, 0xa7, 0x80, 0x92, 0x3f, 0xe8, 0x17, 0xa0, 0xec, 0xae, 0x21, 0x42,
-	0xea, 0x69, 0x77, 0xdf, 0xcc, 0x7b, 0xf3, 0x76, 0xf4, 0xc8, 0x7d, 0x21, 0x59, 0x9d, 0xb3, 0x8f,
-	0x10, 0xa5, 0x05, 0xe3, 0x65, 0xf4, 0xee, 0x38, 0x01, 0xc9, 0x8e, 0xf5, 0x6b, 0x5a, 0x43, 0x8a,
-	0x75, 0x16, 0xce, 0x6b, 0x94, 0x48, 0xef, 0xcd, 0x9b, 0xa4, 0xe0, 0x29, 0x7b, 0x0f, 0x02, 0x4b,
-	0x08, 0x5b, 0x62, 0xa8, 0x5a, 0x43, 0x43, 0xec, 0xef, 0xe7, 0x98, 0xa3, 0x22, 0x44, 0x9b, 0x9b,
-	0xe6, 0xf6, 0xbd, 0x14, 0x45, 0x89, 0x22, 0x4a, 0x98, 0x80, 0x7f, 0x33, 0x90, 0x57, 0xba, 0x1e,
-	0xfc, 0xe8, 0x90, 0xdd, 0xd1, 0x46, 0x67, 0xac, 0x26, 0xd2, 0x87, 0xe4, 0x1a, 0xcb, 0xb2, 0x1a,
-	0x84, 0x70, 0xed, 0x81, 0x3d, 0xbc, 0x11, 0xd3, 0xcb, 0xa5, 0xbf, 0xb7, 0x60, 0x65, 0xf1, 0x34,
-	0x30, 0x85, 0x60, 0xdc, 0xb6, 0xd0, 0x6f, 0x36, 0x71, 0x79, 0xc5, 0x25, 0x67, 0xc5, 0x54, 0xb9,
-	0x61, 0x49, 0x01, 0x53, 0x56, 0x62, 0x53, 0x49, 0xb7, 0x33, 0xe8, 0x0e, 0x77, 0x1f, 0xdf, 0x0d,
-	0xb5, 0x83, 0x70, 0xe3, 0xa0, 0x35, 0x1b, 0x8e, 0x90, 0x57, 0xf1, 0xe4, 0x7c, 0xe9, 0x5b, 0x97,
-	0x4b, 0xdf, 0xd7, 0xf2, 0x57, 0x09, 0x05, 0xdf, 0x7f, 0xf9, 0xc3, 0x9c, 0xcb, 0xb7, 0x4d, 0x12,
-	0xa6, 0x58, 0x46, 0xe6, 0x47, 0xfa, 0x38, 0x12, 0xd9, 0x2c, 0x92, 0x8b, 0x39, 0x08, 0xa5, 0x29,
-	0xc6, 0x07, 0x46, 0x66, 0xd4, 0xaa, 0x9c, 0x28, 0x11, 0x7a, 0x4a, 0x1c, 0x96, 0x4a, 0x8e, 0xd5,
-	0x34, 0xc5, 0x72, 0x5e, 0x80, 0x84, 0xcc, 0xed, 0x0d, 0xba, 0xc3, 0xeb, 0xb1, 0x6f, 0x6c, 0x1c,
-	0x9a, 0x5f, 0xfe, 0xd7, 0x15, 0x8c, 0x6f, 0x69, 0x68, 0xd4, 0x22, 0x0f, 0x3e, 0x91, 0x9d, 0x13,
-	0x05, 0xd1, 0x03, 0x42, 0xf5, 0xed, 0xc5, 0xd6, 0x54, 0xc7, 0xa2, 0x2e, 0xd9, 0xd7, 0x78, 0xdc,
-	0x2c, 0x26, 0x98, 0x72, 0x56, 0x9c, 0xe1, 0x0c, 0x2a, 0xc7, 0xa6, 0xb7, 0xc9, 0x4d, 0x5d, 0x79,
-	0xc9, 0x2b, 0xf9, 0xea, 0xf9, 0x99, 0xd3, 0xa1, 0x7b, 0x84, 0x68, 0xe8, 0x35, 0x4a, 0x70, 0xba,
-	0xf4, 0x90, 0xdc, 0xd1, 0xef, 0x67, 0x50, 0x40, 0xce, 0x24, 0x4c, 0x24, 0x9b, 0x81, 0xd3, 0xeb,
-	0xf7, 0x3e, 0x7f, 0xf5, 0xac, 0xf8, 0xf4, 0x7c, 0xe5, 0xd9, 0x17, 0x2b, 0xcf, 0xfe, 0xbd, 0xf2,
-	0xec, 0x2f, 0x6b, 0xcf, 0xba, 0x58, 0x7b, 0xd6, 0xcf, 0xb5, 0x67, 0xbd, 0x79, 0xb4, 0xb5, 0x25,
-	0x9d, 0x99, 0x23, 0x13, 0x9a, 0xe8, 0x6f, 0xda, 0x3e, 0x98, 0xbc, 0xa9, 0x9d, 0x25, 0x3b, 0x2a,
-	0x05, 0x4f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x95, 0x86, 0xca, 0x8e, 0x02, 0x00, 0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x18, 0x8d, 0x93, 0xd4, 0xb7, 0x77, 0xdc, 0xf6, 0xba, 0x73, 0xa3, 0xd6, 0x64, 0x61, 0x5b, 0x16,
+	0x0b, 0x17, 0xb5, 0x36, 0x0d, 0x0b, 0x24, 0x24, 0x16, 0x75, 0x10, 0xd0, 0x4a, 0xb0, 0x70, 0x2b,
+	0x90, 0x90, 0x50, 0x34, 0xb6, 0xa7, 0xee, 0xa8, 0xb6, 0x27, 0x8a, 0x27, 0x40, 0x10, 0x0f, 0xc0,
+	0xb2, 0xef, 0xc0, 0x06, 0xf1, 0x24, 0x5d, 0x76, 0x89, 0x58, 0xa4, 0xa8, 0x7d, 0x83, 0x6c, 0xd8,
+	0x22, 0xcf, 0x8c, 0x21, 0x20, 0x21, 0x95, 0xae, 0xec, 0xef, 0xef, 0xcc, 0x39, 0xf3, 0x1d, 0x0d,
+	0xd8, 0x28, 0x19, 0x1a, 0xa5, 0xe8, 0x2d, 0xf6, 0xe3, 0x0c, 0x91, 0xdc, 0x7f, 0xb5, 0x1d, 0x61,
+	0x86, 0xb6, 0x45, 0x34, 0x18, 0xe1, 0x98, 0x8e, 0x12, 0x6f, 0x38, 0xa2, 0x8c, 0xc2, 0x9b, 0xc3,
+	0x71, 0x94, 0x91, 0x18, 0xbd, 0xc6, 0x25, 0xcd, 0xb1, 0x57, 0x0f, 0x7a, 0xbc, 0xd5, 0x93, 0x83,
+	0xdd, 0x4e, 0x4a, 0x53, 0xca, 0x07, 0xfc, 0xea, 0x4f, 0xcc, 0x76, 0xad, 0x94, 0xd2, 0x34, 0xc3,
+	0x3e, 0x8f, 0xa2, 0xf1, 0xa1, 0xcf, 0x48, 0x8e, 0x4b, 0x86, 0xf2, 0xa1, 0x6c, 0x30, 0x63, 0x5a,
+	0xe6, 0xb4, 0xf4, 0x23, 0x54, 0xe2, 0x9f, 0x24, 0x28, 0x29, 0x44, 0xdd, 0xf9, 0xd6, 0x04, 0x5a,
+	0xbf, 0x3a, 0x28, 0xe4, 0x94, 0xe0, 0x26, 0xf8, 0x07, 0x25, 0xc9, 0x08, 0x97, 0xa5, 0xa1, 0xd8,
+	0x8a, 0xfb, 0x6f, 0x00, 0x67, 0x53, 0x6b, 0x65, 0x82, 0xf2, 0xec, 0x9e, 0x23, 0x0b, 0x4e, 0x58,
+	0xb7, 0xc0, 0x8f, 0x0a, 0x30, 0x48, 0x41, 0x18, 0x41, 0xd9, 0x80, 0xd3, 0x45, 0x51, 0x86, 0x07,
+	0x28, 0xa7, 0xe3, 0x82, 0x19, 0x4d, 0xbb, 0xe5, 0x6a, 0xbd, 0x1b, 0x9e, 0x60, 0xe0, 0x55, 0x0c,
+	0x6a, 0x35, 0x5e, 0x9f, 0x92, 0x22, 0xd8, 0x3f, 0x9d, 0x5a, 0x8d, 0xd9, 0xd4, 0xb2, 0x04, 0xfc,
+	0x9f, 0x80, 0x9c, 0x4f, 0xe7, 0x96, 0x9b, 0x12, 0x76, 0x34, 0x8e, 0xbc, 0x98, 0xe6, 0xbe, 0x54,
+	0x24, 0x3e, 0x5b, 0x65, 0x72, 0xec, 0xb3, 0xc9, 0x10, 0x97, 0x1c, 0xb3, 0x0c, 0xd7, 0x24, 0x4c,
+	0xbf, 0x46, 0xd9, 0xe1, 0x20, 0x70, 0x0f, 0xe8, 0x28, 0x66, 0x84, 0x16, 0x83, 0x98, 0xe6, 0xc3,
+	0x0c, 0x33, 0x9c, 0x18, 0x6d, 0xbb, 0xe5, 0x2e, 0x06, 0x96, 0xa4, 0xb1, 0x2e, 0x55, 0xfe, 0xd6,
+	0xe5, 0x84, 0xff, 0x89, 0x54, 0xbf, 0xce, 0xc0, 0xfb, 0x60, 0xf9, 0x70, 0x9c, 0x65, 0x13, 0x41,
+	0x15, 0x27, 0x86, 0x6a, 0x2b, 0xee, 0x62, 0x60, 0xcc, 0xa6, 0x56, 0x47, 0x80, 0xfc, 0x52, 0x76,
+	0xc2, 0x25, 0x1e, 0xf7, 0x45, 0xb8, 0xd7, 0x5e, 0x5c, 0xd0, 0x55, 0xe7, 0xac, 0x09, 0xb4, 0x1d,
+	0x01, 0x5c, 0xe5, 0xe1, 0x73, 0xa0, 0x8a, 0x73, 0xf8, 0xc5, 0xaf, 0xf4, 0x36, 0xbd, 0xab, 0xf8,
+	0xc2, 0x13, 0x10, 0xc1, 0xea, 0x6c, 0x6a, 0x2d, 0xcf, 0x0b, 0x70, 0x42, 0x09, 0x07, 0x19, 0x50,
+	0xaf, 0xba, 0x91, 0x1d, 0x79, 0x15, 0x35, 0xd2, 0x35, 0xee, 0x5f, 0x9e, 0x05, 0x37, 0x80, 0x7a,
+	0x84, 0x49, 0x7a, 0xc4, 0x8c, 0x96, 0xad, 0xb8, 0xad, 0x79, 0x82, 0x22, 0xef, 0x84, 0xb2, 0x01,
+	0x3e, 0x02, 0xed, 0xca, 0xb6, 0x46, 0xdb, 0x56, 0x5c, 0xad, 0xd7, 0xf5, 0x84, 0xa7, 0xbd, 0xda,
+	0xd3, 0xde, 0x41, 0xed, 0xe9, 0x60, 0x5d, 0xf2, 0xd3, 0x04, 0x50, 0x35, 0xe5, 0x9c, 0x9c, 0x5b,
+	0x4a, 0xc8, 0x01, 0x9c, 0x2f, 0x0a, 0x80, 0xfc, 0x32, 0x1f, 0x93, 0x92, 0xd1, 0xd1, 0xe4, 0x5a,
+	0x9e, 0xbe, 0x0b, 0xb4, 0x18, 0xe5, 0x43, 0x44, 0xd2, 0x62, 0x40, 0x12, 0xa3, 0x69, 0x2b, 0x6e,
+	0x3b, 0x58, 0x9b, 0x4d, 0x2d, 0x28, 0x26, 0xe6, 0x8a, 0x4e, 0x08, 0xea, 0x68, 0x37, 0x81, 0x2f,
+	0xc1, 0x02, 0x5f, 0x0d, 0x17, 0xac, 0xf5, 0xb6, 0xff, 0x66, 0x7f, 0x9c, 0x75, 0xd0, 0x91, 0xf2,
+	0x96, 0xe4, 0x49, 0x55, 0xd2, 0x09, 0x05, 0xea, 0xad, 0x77, 0x40, 0x15, 0xbd, 0x70, 0x0d, 0x40,
+	0xf1, 0xb7, 0x3b, 0x67, 0x75, 0xbd, 0x01, 0x0d, 0xd0, 0x91, 0x6e, 0x18, 0x4f, 0xf6, 0x69, 0x4c,
+	0x50, 0x76, 0x40, 0x8f, 0x71, 0xa1, 0x2b, 0x70, 0x15, 0x2c, 0x8b, 0xca, 0x13, 0x52, 0xb0, 0xa7,
+	0x0f, 0x0f, 0xf4, 0x26, 0x5c, 0x01, 0x40, 0xa4, 0x9e, 0x51, 0x86, 0xf5, 0x16, 0x5c, 0x07, 0xff,
+	0x8b, 0xf8, 0x01, 0xce, 0x70, 0x8a, 0x18, 0xde, 0x67, 0xe8, 0x18, 0xeb, 0xed, 0x6e, 0xfb, 0xfd,
+	0x07, 0xb3, 0x11, 0xec, 0x9d, 0x5e, 0x98, 0xca, 0xd9, 0x85, 0xa9, 0x7c, 0xbd, 0x30, 0x95, 0x93,
+	0x4b, 0xb3, 0x71, 0x76, 0x69, 0x36, 0x3e, 0x5f, 0x9a, 0x8d, 0x17, 0xb7, 0xe7, 0xac, 0x21, 0x14,
+	0x6f, 0x49, 0xc9, 0xfe, 0x8f, 0x37, 0xf0, 0x8d, 0x7c, 0x05, 0xb9, 0x51, 0x22, 0x95, 0x6f, 0xf6,
+	0xce, 0xf7, 0x01, 0x00, 0xbd, 0xf0, 0xd2, 0x62, 0x24, 0x05, 0x00, 0x00,
 }
 
 func (m *ClaimRecord) Marshal() (dAtA []byte, err error) {
@@ -184,6 +349,16 @@ func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FullyClaimed {
+		i--
+		if m.FullyClaimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.ActionCompleted) > 0 {
 		for iNdEx := len(m.ActionCompleted) - 1; iNdEx >= 0; iNdEx-- {
 			i--
@@ -221,6 +396,106 @@ func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ActionClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintClaimRecord(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintClaimRecord(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaimRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintClaimRecord(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimHistoryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimHistoryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimHistoryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaimRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CampaignId != 0 {
+		i = encodeVarintClaimRecord(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClaimRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClaimRecord(v)
 	base := offset
@@ -251,6 +526,50 @@ func (m *ClaimRecord) Size() (n int) {
 	if len(m.ActionCompleted) > 0 {
 		n += 1 + sovClaimRecord(uint64(len(m.ActionCompleted))) + len(m.ActionCompleted)*1
 	}
+	if m.FullyClaimed {
+		n += 2
+	}
+	return n
+}
+
+func (m *ActionClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovClaimRecord(uint64(m.Action))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovClaimRecord(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovClaimRecord(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovClaimRecord(uint64(l))
+	return n
+}
+
+func (m *ClaimHistoryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovClaimRecord(uint64(m.CampaignId))
+	}
+	l = m.Claim.Size()
+	n += 1 + l + sovClaimRecord(uint64(l))
 	return n
 }
 
@@ -425,6 +744,315 @@ func (m *ClaimRecord) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ActionCompleted", wireType)
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullyClaimed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullyClaimed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaimRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaimRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaimRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimHistoryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaimRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimHistoryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimHistoryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaimRecord(dAtA[iNdEx:])
@@ -6,16 +6,29 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgInitialClaim{}, "claim/InitialClaim", nil)
+	cdc.RegisterConcrete(&MsgClaimFor{}, "claim/ClaimFor", nil)
+	cdc.RegisterConcrete(&MsgCreateCampaign{}, "claim/CreateCampaign", nil)
+	cdc.RegisterConcrete(&MsgReleaseVesting{}, "claim/ReleaseVesting", nil)
+	cdc.RegisterConcrete(&MsgTransferClaimRecord{}, "claim/TransferClaimRecord", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgInitialClaim{},
+		&MsgClaimFor{},
+		&MsgCreateCampaign{},
+		&MsgReleaseVesting{},
+		&MsgTransferClaimRecord{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&ClaimRecordsUpdateProposal{},
+		&CreateCampaignProposal{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -0,0 +1,116 @@
+package types
+
+import (
+	fmt "fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// ln(2) with 18 decimals
+	ln2 = sdk.MustNewDecFromStr("0.693147180559945309")
+	// maximum number of taylor series terms used to compute exponentials
+	maxExpTerms = 40
+)
+
+// NewLinearDecay returns a linear decay function
+func NewLinearDecay() DecayFunction {
+	return DecayFunction{Type: DecayTypeLinear}
+}
+
+// NewStepDecay returns a decay function dropping in equal steps
+func NewStepDecay(steps uint64) DecayFunction {
+	return DecayFunction{Type: DecayTypeStep, Steps: steps}
+}
+
+// NewExponentialDecay returns a decay function halving every half life
+func NewExponentialDecay(halfLife time.Duration) DecayFunction {
+	return DecayFunction{Type: DecayTypeExponential, HalfLife: halfLife}
+}
+
+// ClaimablePercent returns the claimable percent after decaying for elapsed out of duration.
+// Nothing is claimable once the decay duration is over.
+func (d DecayFunction) ClaimablePercent(elapsed, duration time.Duration) sdk.Dec {
+	if elapsed <= 0 {
+		return sdk.OneDec()
+	}
+	if elapsed >= duration {
+		return sdk.ZeroDec()
+	}
+	switch d.Type {
+	case DecayTypeStep:
+		// 1 - floor(elapsed * steps / duration) / steps
+		steps := sdk.NewIntFromUint64(d.Steps)
+		dropped := sdk.NewInt(elapsed.Nanoseconds()).Mul(steps).QuoRaw(duration.Nanoseconds())
+		return sdk.OneDec().Sub(dropped.ToDec().QuoInt(steps))
+	case DecayTypeExponential:
+		// 2^(-elapsed / half life)
+		halvings := sdk.NewDec(elapsed.Nanoseconds()).QuoInt64(d.HalfLife.Nanoseconds())
+		whole := halvings.TruncateInt()
+		if whole.GT(sdk.NewInt(64)) {
+			// below the precision of sdk.Dec
+			return sdk.ZeroDec()
+		}
+		fraction := halvings.Sub(whole.ToDec())
+		percent := exp(fraction.Mul(ln2).Neg())
+		return percent.Mul(sdk.NewDecWithPrec(5, 1).Power(whole.Uint64()))
+	default:
+		// linear
+		decayPercent := sdk.NewDec(elapsed.Nanoseconds()).QuoInt64(duration.Nanoseconds())
+		return sdk.OneDec().Sub(decayPercent)
+	}
+}
+
+// exp computes e^x with a taylor series, deterministic for small |x|
+func exp(x sdk.Dec) sdk.Dec {
+	result := sdk.OneDec()
+	term := sdk.OneDec()
+	for n := 1; n <= maxExpTerms; n++ {
+		term = term.Mul(x).QuoInt64(int64(n))
+		if term.IsZero() {
+			break
+		}
+		result = result.Add(term)
+	}
+	return result
+}
+
+func validateDecayFunction(i interface{}) error {
+	d, ok := i.(DecayFunction)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	switch d.Type {
+	case DecayTypeLinear:
+		return nil
+	case DecayTypeStep:
+		return validateDecaySteps(d.Steps)
+	case DecayTypeExponential:
+		return validateDecayHalfLife(d.HalfLife)
+	default:
+		return fmt.Errorf("invalid decay type: %d", d.Type)
+	}
+}
+
+func validateDecaySteps(i interface{}) error {
+	steps, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if steps < 1 {
+		return fmt.Errorf("decay steps must be greater than or equal to 1: %d", steps)
+	}
+	return nil
+}
+
+func validateDecayHalfLife(i interface{}) error {
+	halfLife, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if halfLife < 1 {
+		return fmt.Errorf("decay half life must be greater than or equal to 1: %d", halfLife)
+	}
+	return nil
+}
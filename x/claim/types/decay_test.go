@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecayFunctionClaimablePercent(t *testing.T) {
+	duration := time.Hour * 100
+	tests := []struct {
+		name     string
+		decay    DecayFunction
+		elapsed  time.Duration
+		expected sdk.Dec
+	}{
+		{"linear start", NewLinearDecay(), 0, sdk.OneDec()},
+		{"linear half", NewLinearDecay(), time.Hour * 50, sdk.NewDecWithPrec(5, 1)},
+		{"linear end", NewLinearDecay(), duration, sdk.ZeroDec()},
+		{"step before first drop", NewStepDecay(4), time.Hour * 24, sdk.OneDec()},
+		{"step first drop", NewStepDecay(4), time.Hour * 25, sdk.NewDecWithPrec(75, 2)},
+		{"step last drop", NewStepDecay(4), time.Hour * 99, sdk.NewDecWithPrec(25, 2)},
+		{"step end", NewStepDecay(4), duration, sdk.ZeroDec()},
+		{"exponential one half life", NewExponentialDecay(time.Hour * 10), time.Hour * 10, sdk.NewDecWithPrec(5, 1)},
+		{"exponential two half lives", NewExponentialDecay(time.Hour * 10), time.Hour * 20, sdk.NewDecWithPrec(25, 2)},
+		{"exponential one and a half half lives", NewExponentialDecay(time.Hour * 10), time.Hour * 15, sdk.MustNewDecFromStr("0.353553390593273762")},
+		{"exponential end", NewExponentialDecay(time.Hour * 10), duration, sdk.ZeroDec()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			percent := tt.decay.ClaimablePercent(tt.elapsed, duration)
+			// allow rounding in the last decimals of the taylor series
+			require.True(t, percent.Sub(tt.expected).Abs().LTE(sdk.NewDecWithPrec(1, 15)),
+				"expected %s, got %s", tt.expected, percent)
+		})
+	}
+}
+
+func TestValidateDecayFunction(t *testing.T) {
+	tests := []struct {
+		name  string
+		decay DecayFunction
+		valid bool
+	}{
+		{"linear", NewLinearDecay(), true},
+		{"step", NewStepDecay(10), true},
+		{"step without steps", NewStepDecay(0), false},
+		{"exponential", NewExponentialDecay(time.Hour), true},
+		{"exponential without half life", NewExponentialDecay(0), false},
+		{"unknown type", DecayFunction{Type: DecayType(5)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDecayFunction(tt.decay)
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
@@ -10,4 +10,13 @@ import (
 var (
 	ErrAirdropNotEnabled             = sdkerrors.Register(ModuleName, 2, "airdrop not enabled")
 	ErrIncorrectModuleAccountBalance = sdkerrors.Register(ModuleName, 3, "claim module account balance != sum of all claim record InitialClaimableAmounts")
+	ErrUnauthorizedClaimer           = sdkerrors.Register(ModuleName, 4, "address is not allowed to claim")
+	ErrInvalidAction                 = sdkerrors.Register(ModuleName, 5, "invalid action")
+	ErrUnauthorizedCampaignCreator   = sdkerrors.Register(ModuleName, 6, "address is not allowed to create campaigns")
+	ErrCampaignNotFound              = sdkerrors.Register(ModuleName, 7, "campaign not found")
+	ErrInvalidCampaign               = sdkerrors.Register(ModuleName, 8, "invalid campaign")
+	ErrAirdropEnded                  = sdkerrors.Register(ModuleName, 9, "airdrop has ended")
+	ErrClaimRecordNotFound           = sdkerrors.Register(ModuleName, 10, "claim record not found")
+	ErrClaimRecordExists             = sdkerrors.Register(ModuleName, 11, "claim record already exists")
+	ErrClaimRecordTransferDisabled   = sdkerrors.Register(ModuleName, 12, "claim record transfer disabled after claim")
 )
@@ -1,6 +1,23 @@
 package types
 
 const (
-	EventTypeClaim         = "claim"
-	AttributeValueCategory = ModuleName
+	EventTypeClaim               = "claim"
+	EventTypeAirdropStarted      = "airdrop_started"
+	EventTypeAirdropEnded        = "airdrop_ended"
+	EventTypeCampaignEnded       = "campaign_ended"
+	EventTypeNewCampaign         = "new_campaign"
+	EventTypeClaimRecordsUpdated = "claim_records_updated"
+	EventTypeClaimVesting        = "claim_vesting"
+	EventTypeReleaseVesting      = "release_vesting"
+	EventTypeTransferClaimRecord = "transfer_claim_record"
+	AttributeValueCategory       = ModuleName
+
+	AttributeKeyAction     = "action"
+	AttributeKeyEndHeight  = "end_height"
+	AttributeKeyStartTime  = "start_time"
+	AttributeKeyCampaignID = "campaign_id"
+	AttributeKeyMinted     = "minted"
+	AttributeKeyBurned     = "burned"
+	AttributeKeyEndTime    = "end_time"
+	AttributeKeyRecipient  = "recipient"
 )
@@ -0,0 +1,1282 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stargaze/claim/v1beta1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventClaim is emitted when an address claims its share for an action
+type EventClaim struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Action  Action                                   `protobuf:"varint,2,opt,name=action,proto3,enum=publicawesome.stargaze.claim.v1beta1.Action" json:"action,omitempty" yaml:"action"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	// campaign the claim is made from, zero for the airdrop
+	CampaignId uint64 `protobuf:"varint,4,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty" yaml:"campaign_id"`
+}
+
+func (m *EventClaim) Reset()         { *m = EventClaim{} }
+func (m *EventClaim) String() string { return proto.CompactTextString(m) }
+func (*EventClaim) ProtoMessage()    {}
+func (*EventClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b35f7087889179c5, []int{0}
+}
+func (m *EventClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaim.Merge(m, src)
+}
+func (m *EventClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClaim proto.InternalMessageInfo
+
+func (m *EventClaim) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventClaim) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return ActionInitialClaim
+}
+
+func (m *EventClaim) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventClaim) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+// EventAirdropStarted is emitted when the airdrop start height is reached
+type EventAirdropStarted struct {
+	Height    int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+}
+
+func (m *EventAirdropStarted) Reset()         { *m = EventAirdropStarted{} }
+func (m *EventAirdropStarted) String() string { return proto.CompactTextString(m) }
+func (*EventAirdropStarted) ProtoMessage()    {}
+func (*EventAirdropStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b35f7087889179c5, []int{1}
+}
+func (m *EventAirdropStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAirdropStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAirdropStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAirdropStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAirdropStarted.Merge(m, src)
+}
+func (m *EventAirdropStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAirdropStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAirdropStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAirdropStarted proto.InternalMessageInfo
+
+func (m *EventAirdropStarted) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventAirdropStarted) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+// EventAirdropEnded is emitted when the airdrop ends and its unclaimed balance
+// is swept to the community pool
+type EventAirdropEnded struct {
+	Swept github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=swept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swept" yaml:"swept"`
+}
+
+func (m *EventAirdropEnded) Reset()         { *m = EventAirdropEnded{} }
+func (m *EventAirdropEnded) String() string { return proto.CompactTextString(m) }
+func (*EventAirdropEnded) ProtoMessage()    {}
+func (*EventAirdropEnded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b35f7087889179c5, []int{2}
+}
+func (m *EventAirdropEnded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAirdropEnded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAirdropEnded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAirdropEnded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAirdropEnded.Merge(m, src)
+}
+func (m *EventAirdropEnded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAirdropEnded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAirdropEnded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAirdropEnded proto.InternalMessageInfo
+
+func (m *EventAirdropEnded) GetSwept() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Swept
+	}
+	return nil
+}
+
+// EventClaimRecordsCleared is emitted when the claim records of the airdrop or
+// of a campaign are cleared
+type EventClaimRecordsCleared struct {
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty" yaml:"count"`
+	// campaign the records belonged to, zero for the airdrop
+	CampaignId uint64 `protobuf:"varint,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty" yaml:"campaign_id"`
+}
+
+func (m *EventClaimRecordsCleared) Reset()         { *m = EventClaimRecordsCleared{} }
+func (m *EventClaimRecordsCleared) String() string { return proto.CompactTextString(m) }
+func (*EventClaimRecordsCleared) ProtoMessage()    {}
+func (*EventClaimRecordsCleared) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b35f7087889179c5, []int{3}
+}
+func (m *EventClaimRecordsCleared) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaimRecordsCleared) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaimRecordsCleared.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClaimRecordsCleared) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaimRecordsCleared.Merge(m, src)
+}
+func (m *EventClaimRecordsCleared) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaimRecordsCleared) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaimRecordsCleared.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClaimRecordsCleared proto.InternalMessageInfo
+
+func (m *EventClaimRecordsCleared) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *EventClaimRecordsCleared) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+// EventCampaignEnded is emitted when a campaign ends and its unclaimed balance
+// is swept to the community pool
+type EventCampaignEnded struct {
+	CampaignId uint64                                   `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty" yaml:"campaign_id"`
+	Swept      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=swept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swept" yaml:"swept"`
+}
+
+func (m *EventCampaignEnded) Reset()         { *m = EventCampaignEnded{} }
+func (m *EventCampaignEnded) String() string { return proto.CompactTextString(m) }
+func (*EventCampaignEnded) ProtoMessage()    {}
+func (*EventCampaignEnded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b35f7087889179c5, []int{4}
+}
+func (m *EventCampaignEnded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCampaignEnded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCampaignEnded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCampaignEnded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCampaignEnded.Merge(m, src)
+}
+func (m *EventCampaignEnded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCampaignEnded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCampaignEnded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCampaignEnded proto.InternalMessageInfo
+
+func (m *EventCampaignEnded) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *EventCampaignEnded) GetSwept() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Swept
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventClaim)(nil), "publicawesome.stargaze.claim.v1beta1.EventClaim")
+	proto.RegisterType((*EventAirdropStarted)(nil), "publicawesome.stargaze.claim.v1beta1.EventAirdropStarted")
+	proto.RegisterType((*EventAirdropEnded)(nil), "publicawesome.stargaze.claim.v1beta1.EventAirdropEnded")
+	proto.RegisterType((*EventClaimRecordsCleared)(nil), "publicawesome.stargaze.claim.v1beta1.EventClaimRecordsCleared")
+	proto.RegisterType((*EventCampaignEnded)(nil), "publicawesome.stargaze.claim.v1beta1.EventCampaignEnded")
+}
+
+func init() {
+	proto.RegisterFile("stargaze/claim/v1beta1/events.proto", fileDescriptor_b35f7087889179c5)
+}
+
+var fileDescriptor_b35f7087889179c5 = []byte{
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xaf, 0xbb, 0xad, 0x68, 0x1e, 0x4c, 0xd4, 0x20, 0x54, 0x2a, 0x91, 0x54, 0x06, 0xa1, 0x4e,
+	0xda, 0x1c, 0x56, 0x0e, 0x48, 0x9c, 0x68, 0xaa, 0x1d, 0xe0, 0x18, 0x90, 0x40, 0x5c, 0x2a, 0x27,
+	0x31, 0xa9, 0x45, 0x13, 0x87, 0xd8, 0xdd, 0x18, 0xdc, 0x39, 0xef, 0xcc, 0x47, 0xe0, 0x53, 0x20,
+	0x4e, 0x3b, 0xee, 0xc8, 0xa9, 0x43, 0xed, 0x37, 0xe8, 0x27, 0x40, 0xb1, 0xdd, 0x3f, 0x42, 0x20,
+	0xca, 0x85, 0x53, 0xe2, 0xf7, 0xde, 0xef, 0xf7, 0x9e, 0x7f, 0x3f, 0xdb, 0xf0, 0xae, 0x54, 0xb4,
+	0x48, 0xe8, 0x07, 0xe6, 0x45, 0x43, 0xca, 0x53, 0xef, 0xf8, 0x30, 0x64, 0x8a, 0x1e, 0x7a, 0xec,
+	0x98, 0x65, 0x4a, 0x92, 0xbc, 0x10, 0x4a, 0xa0, 0x7b, 0xf9, 0x28, 0x1c, 0xf2, 0x88, 0x9e, 0x30,
+	0x29, 0x52, 0x46, 0xe6, 0x10, 0xa2, 0x21, 0xc4, 0x42, 0x9a, 0x37, 0x13, 0x91, 0x08, 0x0d, 0xf0,
+	0xca, 0x3f, 0x83, 0x6d, 0x3a, 0x91, 0x90, 0xa9, 0x90, 0x5e, 0x48, 0x25, 0x5b, 0xb0, 0x47, 0x82,
+	0x67, 0x36, 0xef, 0x26, 0x42, 0x24, 0x43, 0xe6, 0xe9, 0x55, 0x38, 0x7a, 0xe3, 0x29, 0x9e, 0x32,
+	0xa9, 0x68, 0x9a, 0xdb, 0x82, 0xbd, 0x3f, 0x4c, 0xa8, 0x57, 0xfd, 0x82, 0x45, 0xa2, 0x88, 0x4d,
+	0x29, 0xfe, 0x56, 0x85, 0xf0, 0xa8, 0x1c, 0xbc, 0x57, 0xe6, 0xd0, 0x3e, 0xbc, 0x42, 0xe3, 0xb8,
+	0x60, 0x52, 0x36, 0x40, 0x0b, 0xb4, 0xb7, 0x7d, 0x34, 0x1b, 0xbb, 0xbb, 0xa7, 0x34, 0x1d, 0x3e,
+	0xc6, 0x36, 0x81, 0x83, 0x79, 0x09, 0x7a, 0x09, 0x6b, 0x34, 0x52, 0x5c, 0x64, 0x8d, 0x6a, 0x0b,
+	0xb4, 0x77, 0x3b, 0xfb, 0x64, 0x9d, 0x5d, 0x93, 0xae, 0xc6, 0xf8, 0xf5, 0xd9, 0xd8, 0xbd, 0x66,
+	0xa9, 0x75, 0x04, 0x07, 0x96, 0x0e, 0x29, 0x58, 0xa3, 0xa9, 0x18, 0x65, 0xaa, 0xb1, 0xd1, 0xda,
+	0x68, 0xef, 0x74, 0x6e, 0x13, 0x23, 0x09, 0x29, 0x25, 0x59, 0xf0, 0xf4, 0x04, 0xcf, 0xfc, 0xee,
+	0xf9, 0xd8, 0xad, 0xac, 0x30, 0x69, 0x18, 0xfe, 0x72, 0xe9, 0xb6, 0x13, 0xae, 0x06, 0xa3, 0x90,
+	0x44, 0x22, 0xf5, 0xac, 0xa0, 0xe6, 0x73, 0x20, 0xe3, 0xb7, 0x9e, 0x3a, 0xcd, 0x99, 0xd4, 0x0c,
+	0x32, 0xb0, 0xbd, 0xd0, 0x23, 0xb8, 0x13, 0xd1, 0x34, 0xa7, 0x3c, 0xc9, 0xfa, 0x3c, 0x6e, 0x6c,
+	0xb6, 0x40, 0x7b, 0xd3, 0xbf, 0x35, 0x1b, 0xbb, 0xc8, 0x70, 0xaf, 0x24, 0x71, 0x00, 0xe7, 0xab,
+	0xa7, 0x31, 0xfe, 0x0c, 0xe0, 0x0d, 0x2d, 0x62, 0x97, 0x17, 0x71, 0x21, 0xf2, 0xe7, 0x8a, 0x16,
+	0x8a, 0xc5, 0x68, 0x0f, 0xd6, 0x06, 0x8c, 0x27, 0x03, 0xa5, 0xc5, 0xdc, 0x58, 0xdd, 0xb1, 0x89,
+	0xe3, 0xc0, 0x16, 0xa0, 0x57, 0x10, 0x96, 0x6a, 0xa9, 0x7e, 0xe9, 0xa5, 0x96, 0x73, 0xa7, 0xd3,
+	0x24, 0xc6, 0x68, 0x32, 0x37, 0x9a, 0xbc, 0x98, 0x1b, 0xed, 0xdf, 0xb1, 0xdb, 0xae, 0x1b, 0xba,
+	0x25, 0x16, 0x9f, 0x5d, 0xba, 0x20, 0xd8, 0xd6, 0x81, 0xb2, 0x1c, 0x7f, 0x02, 0xb0, 0xbe, 0x3a,
+	0xdc, 0x51, 0x16, 0xb3, 0x18, 0xbd, 0x83, 0x5b, 0xf2, 0x84, 0xe5, 0xe5, 0x64, 0x7f, 0x11, 0xf8,
+	0x89, 0xed, 0x74, 0xd5, 0x76, 0x2a, 0x51, 0xff, 0xa6, 0xaf, 0xe9, 0x84, 0x3f, 0xc2, 0xc6, 0xf2,
+	0xa4, 0x05, 0xfa, 0x10, 0xca, 0xde, 0x90, 0xd1, 0x82, 0xc5, 0xe8, 0x3e, 0xdc, 0x8a, 0xb4, 0xdf,
+	0x40, 0x8b, 0x7e, 0x7d, 0xd9, 0x4f, 0x87, 0x71, 0xb0, 0x15, 0xfd, 0xce, 0xa2, 0xea, 0xda, 0x16,
+	0x7d, 0x05, 0x10, 0x99, 0xee, 0x36, 0x66, 0x64, 0xf8, 0x85, 0x0f, 0xac, 0xcb, 0xb7, 0xd4, 0xaf,
+	0xfa, 0xbf, 0xf4, 0xf3, 0x9f, 0x9d, 0x4f, 0x1c, 0x70, 0x31, 0x71, 0xc0, 0x8f, 0x89, 0x03, 0xce,
+	0xa6, 0x4e, 0xe5, 0x62, 0xea, 0x54, 0xbe, 0x4f, 0x9d, 0xca, 0xeb, 0x07, 0x2b, 0x54, 0xe6, 0x06,
+	0x1e, 0xd8, 0x2b, 0xe8, 0x2d, 0x5e, 0x82, 0xf7, 0xf6, 0x2d, 0xd0, 0xc4, 0x61, 0x4d, 0x1f, 0xa9,
+	0x87, 0x3f, 0x07, 0x00, 0x10, 0x37, 0x85, 0x13, 0xcc, 0x04, 0x00, 0x00,
+}
+
+func (m *EventClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAirdropStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAirdropStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAirdropStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvents(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAirdropEnded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAirdropEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAirdropEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Swept) > 0 {
+		for iNdEx := len(m.Swept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClaimRecordsCleared) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimRecordsCleared) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimRecordsCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCampaignEnded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCampaignEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCampaignEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Swept) > 0 {
+		for iNdEx := len(m.Swept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovEvents(uint64(m.Action))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovEvents(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *EventAirdropStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventAirdropEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Swept) > 0 {
+		for _, e := range m.Swept {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventClaimRecordsCleared) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovEvents(uint64(m.Count))
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovEvents(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *EventCampaignEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovEvents(uint64(m.CampaignId))
+	}
+	if len(m.Swept) > 0 {
+		for _, e := range m.Swept {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAirdropStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAirdropStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAirdropStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAirdropEnded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAirdropEnded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAirdropEnded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swept = append(m.Swept, types.Coin{})
+			if err := m.Swept[len(m.Swept)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaimRecordsCleared) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaimRecordsCleared: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaimRecordsCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCampaignEnded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCampaignEnded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCampaignEnded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swept = append(m.Swept, types.Coin{})
+			if err := m.Swept[len(m.Swept)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -30,6 +31,9 @@ func DefaultParams() Params {
 		DurationUntilDecay: DefaultDurationUntilDecay,
 		DurationOfDecay:    DefaultDurationOfDecay,
 		ClaimDenom:         DefaultClaimDenom,
+		ActionWeights:      DefaultActionWeights(),
+		DecayFunction:      NewLinearDecay(),
+		VestingFraction:    sdk.ZeroDec(),
 	}
 }
 
@@ -37,6 +41,9 @@ func DefaultParams() Params {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
 	totalClaimable := sdk.Coins{}
 	for _, claimRecord := range gs.ClaimRecords {
 		totalClaimable = totalClaimable.Add(claimRecord.InitialClaimableAmount...)
@@ -45,6 +52,66 @@ func (gs GenesisState) Validate() error {
 	if !totalClaimable.IsEqual(sdk.NewCoins(gs.ModuleAccountBalance)) {
 		return ErrIncorrectModuleAccountBalance
 	}
+	if _, ok := AirdropStage_name[int32(gs.AirdropState.Stage)]; !ok {
+		return fmt.Errorf("invalid airdrop stage: %d", gs.AirdropState.Stage)
+	}
+	if gs.AirdropState.Stage == AirdropStageEnded && len(gs.ClaimRecords) > 0 {
+		return fmt.Errorf("airdrop ended but %d claim records remain", len(gs.ClaimRecords))
+	}
+	for _, claimed := range gs.AirdropState.Claimed {
+		if _, ok := Action_name[int32(claimed.Action)]; !ok {
+			return fmt.Errorf("invalid claimed action: %d", claimed.Action)
+		}
+		if err := claimed.Amount.Validate(); err != nil {
+			return err
+		}
+	}
+	campaigns := make(map[uint64]Campaign)
+	for _, campaign := range gs.Campaigns {
+		if campaign.Id == 0 {
+			return fmt.Errorf("invalid campaign id: 0")
+		}
+		if _, ok := campaigns[campaign.Id]; ok {
+			return fmt.Errorf("duplicate campaign id: %d", campaign.Id)
+		}
+		if err := campaign.Validate(); err != nil {
+			return err
+		}
+		campaigns[campaign.Id] = campaign
+	}
+	for _, record := range gs.CampaignClaimRecords {
+		campaign, ok := campaigns[record.CampaignId]
+		if !ok {
+			return fmt.Errorf("claim record of %s references unknown campaign %d", record.ClaimRecord.Address, record.CampaignId)
+		}
+		if campaign.Ended {
+			return fmt.Errorf("claim record of %s references ended campaign %d", record.ClaimRecord.Address, record.CampaignId)
+		}
+		if _, err := sdk.AccAddressFromBech32(record.ClaimRecord.Address); err != nil {
+			return err
+		}
+	}
+	escrows := make(map[string]bool)
+	for _, escrow := range gs.VestingEscrows {
+		if escrows[escrow.Address] {
+			return fmt.Errorf("duplicate vesting escrow for %s", escrow.Address)
+		}
+		escrows[escrow.Address] = true
+		if err := escrow.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, record := range gs.ClaimHistory {
+		if _, err := sdk.AccAddressFromBech32(record.Address); err != nil {
+			return err
+		}
+		if _, ok := Action_name[int32(record.Claim.Action)]; !ok {
+			return fmt.Errorf("invalid claim history action: %d", record.Claim.Action)
+		}
+		if err := record.Claim.Amount.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -33,6 +33,16 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
 	// list of claim records, one for every airdrop recipient
 	ClaimRecords []ClaimRecord `protobuf:"bytes,3,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records" yaml:"claim_records"`
+	// lifecycle state of the airdrop
+	AirdropState AirdropState `protobuf:"bytes,4,opt,name=airdrop_state,json=airdropState,proto3" json:"airdrop_state" yaml:"airdrop_state"`
+	// airdrop campaigns, their funds are held by the claim module account
+	Campaigns []Campaign `protobuf:"bytes,5,rep,name=campaigns,proto3" json:"campaigns" yaml:"campaigns"`
+	// claim records of all campaigns
+	CampaignClaimRecords []CampaignClaimRecord `protobuf:"bytes,6,rep,name=campaign_claim_records,json=campaignClaimRecords,proto3" json:"campaign_claim_records" yaml:"campaign_claim_records"`
+	// vesting escrows of claims, their funds are held by the claim module account
+	VestingEscrows []VestingEscrow `protobuf:"bytes,7,rep,name=vesting_escrows,json=vestingEscrows,proto3" json:"vesting_escrows" yaml:"vesting_escrows"`
+	// claims of the airdrop and all campaigns, kept after they ended
+	ClaimHistory []ClaimHistoryRecord `protobuf:"bytes,8,rep,name=claim_history,json=claimHistory,proto3" json:"claim_history" yaml:"claim_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,6 +99,41 @@ func (m *GenesisState) GetClaimRecords() []ClaimRecord {
 	return nil
 }
 
+func (m *GenesisState) GetAirdropState() AirdropState {
+	if m != nil {
+		return m.AirdropState
+	}
+	return AirdropState{}
+}
+
+func (m *GenesisState) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCampaignClaimRecords() []CampaignClaimRecord {
+	if m != nil {
+		return m.CampaignClaimRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingEscrows() []VestingEscrow {
+	if m != nil {
+		return m.VestingEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimHistory() []ClaimHistoryRecord {
+	if m != nil {
+		return m.ClaimHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "publicawesome.stargaze.claim.v1beta1.GenesisState")
 }
@@ -98,30 +143,42 @@ func init() {
 }
 
 var fileDescriptor_cac1d615666a45cf = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0x86, 0x5b, 0x48, 0x58, 0x14, 0xd8, 0x34, 0xdc, 0x1b, 0x2e, 0xb9, 0x0e, 0xa4, 0x62, 0x82,
-	0x89, 0xce, 0x08, 0xee, 0xdc, 0x59, 0x16, 0x26, 0xae, 0x4c, 0xdd, 0xe9, 0x82, 0x4c, 0x87, 0x49,
-	0x6d, 0xd2, 0xe9, 0x34, 0x9d, 0x29, 0x8a, 0x4f, 0xe1, 0x63, 0xb1, 0x64, 0xa9, 0x1b, 0x62, 0xe0,
-	0x0d, 0x7c, 0x02, 0xc3, 0xcc, 0x80, 0x9a, 0x48, 0xc2, 0xae, 0x73, 0xce, 0xf9, 0xff, 0xff, 0xeb,
-	0x39, 0x4e, 0x57, 0x48, 0x9c, 0x47, 0xf8, 0x99, 0x22, 0x92, 0xe0, 0x98, 0xa1, 0x49, 0x3f, 0xa4,
-	0x12, 0xf7, 0x51, 0x44, 0x53, 0x2a, 0x62, 0x01, 0xb3, 0x9c, 0x4b, 0xee, 0x76, 0xb3, 0x22, 0x4c,
-	0x62, 0x82, 0x1f, 0xa9, 0xe0, 0x8c, 0xc2, 0x8d, 0x06, 0x2a, 0x0d, 0x34, 0x9a, 0x56, 0x23, 0xe2,
-	0x11, 0x57, 0x02, 0xb4, 0xfe, 0xd2, 0xda, 0x16, 0x20, 0x5c, 0x30, 0x2e, 0x50, 0x88, 0x05, 0xdd,
-	0xda, 0x13, 0x1e, 0xa7, 0xa6, 0x7f, 0xbc, 0x83, 0x40, 0xbd, 0x46, 0x39, 0x25, 0x3c, 0x1f, 0x9b,
-	0xd1, 0xc3, 0x1d, 0xa3, 0x19, 0xce, 0x31, 0x33, 0xac, 0xde, 0x5b, 0xc9, 0xa9, 0x5d, 0x69, 0xfa,
-	0x5b, 0x89, 0x25, 0x75, 0x27, 0xce, 0x5f, 0xc6, 0xc7, 0x45, 0x42, 0x47, 0x98, 0x10, 0x5e, 0xa4,
-	0x72, 0x14, 0xe2, 0x04, 0xa7, 0x84, 0x36, 0xed, 0x8e, 0xdd, 0xab, 0x0e, 0xfe, 0x41, 0x4d, 0x08,
-	0xd7, 0x84, 0x9b, 0x9f, 0x81, 0x43, 0x1e, 0xa7, 0xfe, 0xd1, 0x6c, 0xd1, 0xb6, 0x3e, 0x16, 0xed,
-	0x83, 0x29, 0x66, 0xc9, 0x85, 0xf7, 0xbb, 0x8d, 0x17, 0x34, 0x74, 0xe3, 0x52, 0xd7, 0x7d, 0x5d,
-	0x76, 0xef, 0x9d, 0x8a, 0x06, 0x6b, 0x96, 0x54, 0xce, 0x09, 0xdc, 0x67, 0x8b, 0xf0, 0x46, 0x69,
-	0xfc, 0x3f, 0x26, 0xba, 0xae, 0xa3, 0xb5, 0x93, 0x17, 0x18, 0x4b, 0x57, 0x3a, 0xf5, 0xef, 0x0b,
-	0x12, 0xcd, 0x72, 0xa7, 0xdc, 0xab, 0x0e, 0xfa, 0xfb, 0x65, 0x0c, 0xd7, 0xaf, 0x40, 0x29, 0xfd,
-	0xff, 0x26, 0xa8, 0xa1, 0x83, 0x7e, 0xb8, 0x7a, 0x41, 0x8d, 0x7c, 0x8d, 0x0a, 0xff, 0x7a, 0xb6,
-	0x04, 0xf6, 0x7c, 0x09, 0xec, 0xf7, 0x25, 0xb0, 0x5f, 0x56, 0xc0, 0x9a, 0xaf, 0x80, 0xf5, 0xba,
-	0x02, 0xd6, 0xdd, 0x59, 0x14, 0xcb, 0x87, 0x22, 0x84, 0x84, 0x33, 0xa4, 0x11, 0x4e, 0x0d, 0x03,
-	0xda, 0x1e, 0xed, 0xc9, 0x9c, 0x4d, 0x4e, 0x33, 0x2a, 0xc2, 0x8a, 0x3a, 0xd7, 0xf9, 0x67, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xcc, 0x74, 0x9b, 0x0a, 0x82, 0x02, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x30,
+	0x1c, 0x6f, 0x18, 0x74, 0x90, 0xb5, 0x80, 0xa2, 0x52, 0x85, 0x0a, 0xb2, 0x29, 0x6c, 0xd2, 0xf8,
+	0x72, 0x68, 0x77, 0x01, 0x6e, 0xcb, 0x84, 0x40, 0x9c, 0x50, 0x90, 0x38, 0xc0, 0x21, 0x72, 0x5c,
+	0x93, 0x59, 0x4a, 0xe2, 0x28, 0x76, 0x3a, 0x0a, 0x3c, 0x04, 0x27, 0x9e, 0x69, 0x07, 0x0e, 0x3b,
+	0x72, 0x9a, 0x50, 0xfb, 0x06, 0x3c, 0x01, 0x8a, 0xed, 0x6c, 0x4d, 0x59, 0xa6, 0xf4, 0x16, 0x27,
+	0xbf, 0xaf, 0xff, 0x3f, 0x3f, 0x59, 0xdf, 0x66, 0x1c, 0x66, 0x21, 0xfc, 0x8a, 0x1d, 0x14, 0x41,
+	0x12, 0x3b, 0x93, 0x61, 0x80, 0x39, 0x1c, 0x3a, 0x21, 0x4e, 0x30, 0x23, 0x0c, 0xa4, 0x19, 0xe5,
+	0xd4, 0xd8, 0x4e, 0xf3, 0x20, 0x22, 0x08, 0x1e, 0x61, 0x46, 0x63, 0x0c, 0x4a, 0x0e, 0x10, 0x1c,
+	0xa0, 0x38, 0x83, 0x5e, 0x48, 0x43, 0x2a, 0x08, 0x4e, 0xf1, 0x24, 0xb9, 0x03, 0x0b, 0x51, 0x16,
+	0x53, 0xe6, 0x04, 0x90, 0xe1, 0x33, 0x79, 0x44, 0x49, 0xa2, 0xbe, 0x3f, 0xaa, 0x49, 0x00, 0x49,
+	0x36, 0xce, 0x68, 0xea, 0x33, 0x0e, 0x39, 0x56, 0xd8, 0x9d, 0x1a, 0x2c, 0x82, 0x71, 0x0a, 0x49,
+	0x58, 0x4a, 0x3e, 0xac, 0x83, 0x15, 0x27, 0x3f, 0xc3, 0x88, 0x66, 0x63, 0x05, 0x7d, 0x50, 0x03,
+	0x4d, 0x61, 0x06, 0x63, 0x35, 0xfe, 0xe0, 0x71, 0x0d, 0x68, 0x82, 0x19, 0x27, 0x49, 0xe8, 0x63,
+	0x86, 0x32, 0x7a, 0x24, 0xc1, 0xf6, 0xaf, 0x75, 0xbd, 0xf3, 0x5a, 0x6e, 0xef, 0x7d, 0x11, 0xdd,
+	0x98, 0xe8, 0xfd, 0x98, 0x8e, 0xf3, 0x08, 0xfb, 0x10, 0x21, 0x9a, 0x27, 0xdc, 0x0f, 0x60, 0x04,
+	0x13, 0x84, 0x4d, 0x6d, 0x4b, 0xdb, 0xdd, 0x18, 0xdd, 0x05, 0x72, 0x43, 0xa0, 0xd8, 0x50, 0xb9,
+	0x4c, 0x70, 0x40, 0x49, 0xe2, 0xee, 0x1c, 0x9f, 0x6e, 0xb6, 0xfe, 0x9e, 0x6e, 0xde, 0x9f, 0xc2,
+	0x38, 0x7a, 0x69, 0x5f, 0x2c, 0x63, 0x7b, 0x3d, 0xf9, 0x61, 0x5f, 0xbe, 0x77, 0xe5, 0x6b, 0xe3,
+	0x93, 0xde, 0x96, 0x53, 0x98, 0x57, 0x84, 0xcf, 0x13, 0xd0, 0xe4, 0x2f, 0x82, 0x77, 0x82, 0xe3,
+	0xde, 0x51, 0xd6, 0x5d, 0x69, 0x2d, 0x95, 0x6c, 0x4f, 0x49, 0x1a, 0x5c, 0xef, 0x2e, 0x6e, 0x93,
+	0x99, 0x6b, 0x5b, 0x6b, 0xbb, 0x1b, 0xa3, 0x61, 0x33, 0x8f, 0x83, 0xe2, 0xe4, 0x09, 0xa6, 0x7b,
+	0x4f, 0x19, 0xf5, 0xa4, 0x51, 0x45, 0xd5, 0xf6, 0x3a, 0xe8, 0x1c, 0xca, 0x8c, 0x5c, 0xef, 0x56,
+	0x6a, 0x61, 0x5e, 0x15, 0x93, 0x8d, 0x9a, 0xb9, 0xee, 0x4b, 0xaa, 0xf8, 0x2b, 0xcb, 0xb6, 0x15,
+	0x59, 0xdb, 0xeb, 0xc0, 0x05, 0xac, 0xf1, 0x59, 0xbf, 0x51, 0x36, 0x8c, 0x99, 0xd7, 0xc4, 0xa0,
+	0xa0, 0xe1, 0xa0, 0x8a, 0xe6, 0x9a, 0xca, 0xee, 0xb6, 0x9a, 0xb2, 0x94, 0xb3, 0xbd, 0x73, 0x69,
+	0xe3, 0xa7, 0xa6, 0xf7, 0xcb, 0x93, 0x5f, 0x5d, 0x6f, 0x5b, 0xb8, 0xbe, 0x58, 0xcd, 0x75, 0x71,
+	0xcd, 0x4b, 0x55, 0xba, 0xd8, 0xc6, 0xf6, 0x7a, 0xe8, 0x7f, 0x2e, 0x33, 0xbe, 0xeb, 0xb7, 0xaa,
+	0x5d, 0x67, 0xe6, 0xba, 0x08, 0xb4, 0xd7, 0x2c, 0xd0, 0x07, 0x49, 0x7e, 0x25, 0xb8, 0xae, 0xa5,
+	0xa2, 0xf4, 0x65, 0x94, 0x25, 0x65, 0xdb, 0xbb, 0x39, 0x59, 0x84, 0x33, 0xe3, 0x5b, 0xd9, 0xb5,
+	0x43, 0xc2, 0x38, 0xcd, 0xa6, 0xe6, 0x75, 0xe1, 0xfd, 0x7c, 0x85, 0xae, 0xbd, 0x91, 0xcc, 0xcb,
+	0x2a, 0xa7, 0xc4, 0xcb, 0xca, 0x29, 0x86, 0xfb, 0xf6, 0x78, 0x66, 0x69, 0x27, 0x33, 0x4b, 0xfb,
+	0x33, 0xb3, 0xb4, 0x1f, 0x73, 0xab, 0x75, 0x32, 0xb7, 0x5a, 0xbf, 0xe7, 0x56, 0xeb, 0xe3, 0xb3,
+	0x90, 0xf0, 0xc3, 0x3c, 0x00, 0x88, 0xc6, 0x8e, 0x4c, 0xf2, 0x54, 0x45, 0x71, 0xce, 0xee, 0x8b,
+	0x2f, 0xea, 0xc6, 0xe0, 0xd3, 0x14, 0xb3, 0xa0, 0x2d, 0x6e, 0x88, 0xbd, 0x7f, 0x03, 0x00, 0xcf,
+	0x55, 0x04, 0xb1, 0x75, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -144,6 +201,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimHistory) > 0 {
+		for iNdEx := len(m.ClaimHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.VestingEscrows) > 0 {
+		for iNdEx := len(m.VestingEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CampaignClaimRecords) > 0 {
+		for iNdEx := len(m.CampaignClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CampaignClaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.AirdropState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.ClaimRecords) > 0 {
 		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +331,32 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.AirdropState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CampaignClaimRecords) > 0 {
+		for _, e := range m.CampaignClaimRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingEscrows) > 0 {
+		for _, e := range m.VestingEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimHistory) > 0 {
+		for _, e := range m.ClaimHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -346,6 +495,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AirdropState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignClaimRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CampaignClaimRecords = append(m.CampaignClaimRecords, CampaignClaimRecord{})
+			if err := m.CampaignClaimRecords[len(m.CampaignClaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingEscrows = append(m.VestingEscrows, VestingEscrow{})
+			if err := m.VestingEscrows[len(m.VestingEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHistory = append(m.ClaimHistory, ClaimHistoryRecord{})
+			if err := m.ClaimHistory[len(m.ClaimHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
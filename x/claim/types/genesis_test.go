@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/public-awesome/stargaze/testutil/sample"
 	"github.com/public-awesome/stargaze/x/claim/types"
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +37,86 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "ended airdrop with claim records",
+			genState: &types.GenesisState{
+				ModuleAccountBalance: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				Params:               types.DefaultParams(),
+				ClaimRecords: []types.ClaimRecord{
+					{
+						Address:                "stars1",
+						InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+						ActionCompleted:        []bool{false, false, false, false, false},
+					},
+				},
+				AirdropState: types.AirdropState{Stage: types.AirdropStageEnded, EndHeight: 10},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid airdrop stage",
+			genState: &types.GenesisState{
+				ModuleAccountBalance: sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+				Params:               types.DefaultParams(),
+				ClaimRecords:         []types.ClaimRecord{},
+				AirdropState:         types.AirdropState{Stage: types.AirdropStage(10)},
+			},
+			valid: false,
+		},
+		{
+			desc: "action weights not summing to one",
+			genState: &types.GenesisState{
+				ModuleAccountBalance: sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+				Params: types.Params{
+					AirdropEnabled:     true,
+					DurationUntilDecay: time.Hour,
+					DurationOfDecay:    time.Hour,
+					ClaimDenom:         sdk.DefaultBondDenom,
+					ActionWeights: []types.ActionWeight{
+						{Action: types.ActionInitialClaim, Weight: sdk.NewDecWithPrec(5, 1)},
+						{Action: types.ActionVote, Weight: sdk.NewDecWithPrec(4, 1)},
+					},
+				},
+				ClaimRecords: []types.ClaimRecord{},
+			},
+			valid: false,
+		},
+		{
+			desc: "campaign claim record of unknown campaign",
+			genState: &types.GenesisState{
+				ModuleAccountBalance: sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+				Params:               types.DefaultParams(),
+				ClaimRecords:         []types.ClaimRecord{},
+				Campaigns:            []types.Campaign{campaign(1)},
+				CampaignClaimRecords: []types.CampaignClaimRecord{
+					{CampaignId: 2, ClaimRecord: types.ClaimRecord{Address: sample.AccAddress()}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate campaign id",
+			genState: &types.GenesisState{
+				ModuleAccountBalance: sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+				Params:               types.DefaultParams(),
+				ClaimRecords:         []types.ClaimRecord{},
+				Campaigns:            []types.Campaign{campaign(1), campaign(1)},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid campaigns",
+			genState: &types.GenesisState{
+				ModuleAccountBalance: sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+				Params:               types.DefaultParams(),
+				ClaimRecords:         []types.ClaimRecord{},
+				Campaigns:            []types.Campaign{campaign(1), campaign(2)},
+				CampaignClaimRecords: []types.CampaignClaimRecord{
+					{CampaignId: 2, ClaimRecord: types.ClaimRecord{Address: sample.AccAddress()}},
+				},
+			},
+			valid: true,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -48,3 +129,15 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func campaign(id uint64) types.Campaign {
+	return types.Campaign{
+		Id:                 id,
+		Name:               "campaign",
+		Denom:              sdk.DefaultBondDenom,
+		DurationUntilDecay: time.Hour,
+		DurationOfDecay:    time.Hour,
+		Funder:             sample.AccAddress(),
+		Balance:            sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClaimHooks defines the hooks other modules call to complete claim actions
+// that are performed outside of the SDK modules the claim keeper already
+// listens to (staking and gov).
+type ClaimHooks interface {
+	AfterMintNFT(ctx sdk.Context, minterAddr sdk.AccAddress)
+	AfterBuySocialToken(ctx sdk.Context, buyerAddr sdk.AccAddress)
+}
+
+var _ ClaimHooks = MultiClaimHooks{}
+
+// MultiClaimHooks combines multiple claim hooks, all hook functions are run in array sequence
+type MultiClaimHooks []ClaimHooks
+
+func NewMultiClaimHooks(hooks ...ClaimHooks) MultiClaimHooks {
+	return hooks
+}
+
+func (h MultiClaimHooks) AfterMintNFT(ctx sdk.Context, minterAddr sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterMintNFT(ctx, minterAddr)
+	}
+}
+
+func (h MultiClaimHooks) AfterBuySocialToken(ctx sdk.Context, buyerAddr sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterBuySocialToken(ctx, buyerAddr)
+	}
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "claim"
@@ -21,10 +26,74 @@ const (
 
 	// ActionKey defines the store key to store user accomplished actions
 	ActionKey = "action"
+
+	// MaxClaimableScheduleCount is the maximum number of projections returned by the claimable schedule query
+	MaxClaimableScheduleCount = 100
+
+	// IBCClaimMemo is the ICS-20 transfer memo completing the initial claim of the receiver
+	IBCClaimMemo = "claim"
+
+	// Query endpoints supported by the claim querier
+	QueryParameters           = "parameters"
+	QueryClaimRecord          = "claim_record"
+	QueryClaimableForAction   = "claimable_for_action"
+	QueryTotalClaimable       = "total_claimable"
+	QueryModuleAccountBalance = "module_account_balance"
 )
 
 // KVStore keys
 var (
 	// ClaimRecordsStorePrefix defines the store prefix for the claim records
 	ClaimRecordsStorePrefix = []byte{0x01}
+
+	// AirdropStateKey defines the store key for the airdrop lifecycle state
+	AirdropStateKey = []byte{0x02}
+
+	// CampaignsStorePrefix defines the store prefix for the airdrop campaigns
+	CampaignsStorePrefix = []byte{0x03}
+
+	// CampaignClaimRecordsStorePrefix defines the store prefix for the campaign claim records
+	CampaignClaimRecordsStorePrefix = []byte{0x04}
+
+	// NextCampaignIDKey defines the store key for the next campaign id
+	NextCampaignIDKey = []byte{0x05}
+
+	// VestingEscrowsStorePrefix defines the store prefix for the vesting escrows of claims
+	VestingEscrowsStorePrefix = []byte{0x06}
+
+	// FreeInitialClaimsStorePrefix defines the store prefix for the addresses which used their fee-free initial claim
+	FreeInitialClaimsStorePrefix = []byte{0x07}
+
+	// ActiveCampaignsStorePrefix defines the store prefix indexing the campaigns which didn't end yet
+	ActiveCampaignsStorePrefix = []byte{0x08}
+
+	// ClaimHistoryStorePrefix defines the store prefix for the claims of the airdrop and all campaigns
+	ClaimHistoryStorePrefix = []byte{0x09}
 )
+
+// CampaignKey returns the store key of a campaign
+func CampaignKey(id uint64) []byte {
+	return append(CampaignsStorePrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ActiveCampaignKey returns the store key of a campaign in the active campaign index
+func ActiveCampaignKey(id uint64) []byte {
+	return append(ActiveCampaignsStorePrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// CampaignClaimRecordsPrefix returns the store prefix for the claim records of a campaign
+func CampaignClaimRecordsPrefix(id uint64) []byte {
+	return append(CampaignClaimRecordsStorePrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ClaimHistoryPrefix returns the store prefix for the claim history of an address
+func ClaimHistoryPrefix(addr sdk.AccAddress) []byte {
+	return append(ClaimHistoryStorePrefix, address.MustLengthPrefix(addr)...)
+}
+
+// ClaimHistoryKey returns the store key of the claim of an address for an action of a campaign,
+// campaign 0 being the airdrop
+func ClaimHistoryKey(addr sdk.AccAddress, campaignID uint64, action Action) []byte {
+	key := append(ClaimHistoryPrefix(addr), sdk.Uint64ToBigEndian(campaignID)...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(action))...)
+}
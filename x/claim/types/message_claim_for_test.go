@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/public-awesome/stargaze/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgClaimFor_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgClaimFor
+		err  error
+	}{
+		{
+			name: "invalid sender",
+			msg: MsgClaimFor{
+				Sender:  "invalid_address",
+				Address: sample.AccAddress(),
+				Action:  ActionMintNFT,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid address",
+			msg: MsgClaimFor{
+				Sender:  sample.AccAddress(),
+				Address: "invalid_address",
+				Action:  ActionMintNFT,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid action",
+			msg: MsgClaimFor{
+				Sender:  sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Action:  Action(99),
+			},
+			err: ErrInvalidAction,
+		}, {
+			name: "valid",
+			msg: MsgClaimFor{
+				Sender:  sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Action:  ActionMintNFT,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
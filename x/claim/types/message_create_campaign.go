@@ -0,0 +1,109 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateCampaign{}
+
+// msg types
+const (
+	TypeMsgCreateCampaign = "create_campaign"
+)
+
+func NewMsgCreateCampaign(
+	sender string,
+	name string,
+	denom string,
+	startTime time.Time,
+	durationUntilDecay, durationOfDecay time.Duration,
+	decay DecayFunction,
+	actionWeights []ActionWeight,
+	claimRecords []ClaimRecord,
+) *MsgCreateCampaign {
+	return &MsgCreateCampaign{
+		Sender:             sender,
+		Name:               name,
+		Denom:              denom,
+		StartTime:          startTime,
+		DurationUntilDecay: durationUntilDecay,
+		DurationOfDecay:    durationOfDecay,
+		DecayFunction:      decay,
+		ActionWeights:      actionWeights,
+		ClaimRecords:       claimRecords,
+	}
+}
+
+func (msg *MsgCreateCampaign) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateCampaign) Type() string {
+	return TypeMsgCreateCampaign
+}
+
+func (msg *MsgCreateCampaign) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgCreateCampaign) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateCampaign) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return validateCampaign(msg.Name, msg.Denom, msg.DurationUntilDecay, msg.DurationOfDecay, msg.DecayFunction, msg.ActionWeights, msg.ClaimRecords)
+}
+
+// validateCampaign checks the schedule and claim records of a new campaign
+func validateCampaign(
+	name, denom string,
+	durationUntilDecay, durationOfDecay time.Duration,
+	decay DecayFunction,
+	actionWeights []ActionWeight,
+	claimRecords []ClaimRecord,
+) error {
+	err := validateCampaignSchedule(name, denom, durationUntilDecay, durationOfDecay, decay, actionWeights)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidCampaign, err.Error())
+	}
+	if len(claimRecords) == 0 {
+		return sdkerrors.Wrap(ErrInvalidCampaign, "no claim records")
+	}
+	seen := make(map[string]bool)
+	for _, record := range claimRecords {
+		_, err := sdk.AccAddressFromBech32(record.Address)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claim record address (%s)", err)
+		}
+		if seen[record.Address] {
+			return sdkerrors.Wrapf(ErrInvalidCampaign, "duplicate claim record for %s", record.Address)
+		}
+		seen[record.Address] = true
+		amount := record.InitialClaimableAmount
+		if !amount.IsValid() || len(amount) != 1 || amount[0].Denom != denom {
+			return sdkerrors.Wrapf(ErrInvalidCampaign, "claim record of %s must be a positive amount of %s: %s", record.Address, denom, amount)
+		}
+	}
+	return nil
+}
+
+// TotalClaimable returns the sum of all initial claimable amounts of the campaign
+func (msg *MsgCreateCampaign) TotalClaimable() sdk.Coin {
+	total := sdk.NewCoin(msg.Denom, sdk.ZeroInt())
+	for _, record := range msg.ClaimRecords {
+		total = total.Add(sdk.NewCoin(msg.Denom, record.InitialClaimableAmount.AmountOf(msg.Denom)))
+	}
+	return total
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/public-awesome/stargaze/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateCampaign_ValidateBasic(t *testing.T) {
+	addr := sample.AccAddress()
+	record := func(address string, amount sdk.Coins) []ClaimRecord {
+		return []ClaimRecord{{Address: address, InitialClaimableAmount: amount}}
+	}
+	valid := func() MsgCreateCampaign {
+		return *NewMsgCreateCampaign(
+			sample.AccAddress(), "nft creators", "ustars", time.Time{},
+			time.Hour, time.Hour, NewLinearDecay(), nil,
+			record(addr, sdk.NewCoins(sdk.NewInt64Coin("ustars", 100))),
+		)
+	}
+	tests := []struct {
+		name   string
+		modify func(msg *MsgCreateCampaign)
+		err    error
+	}{
+		{
+			name:   "invalid sender",
+			modify: func(msg *MsgCreateCampaign) { msg.Sender = "invalid_address" },
+			err:    sdkerrors.ErrInvalidAddress,
+		}, {
+			name:   "empty name",
+			modify: func(msg *MsgCreateCampaign) { msg.Name = "" },
+			err:    ErrInvalidCampaign,
+		}, {
+			name:   "invalid decay function",
+			modify: func(msg *MsgCreateCampaign) { msg.DecayFunction = NewStepDecay(0) },
+			err:    ErrInvalidCampaign,
+		}, {
+			name:   "no claim records",
+			modify: func(msg *MsgCreateCampaign) { msg.ClaimRecords = nil },
+			err:    ErrInvalidCampaign,
+		}, {
+			name: "duplicate claim records",
+			modify: func(msg *MsgCreateCampaign) {
+				msg.ClaimRecords = append(msg.ClaimRecords, msg.ClaimRecords[0])
+			},
+			err: ErrInvalidCampaign,
+		}, {
+			name: "invalid claim record address",
+			modify: func(msg *MsgCreateCampaign) {
+				msg.ClaimRecords = record("invalid_address", sdk.NewCoins(sdk.NewInt64Coin("ustars", 100)))
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "claim record of another denom",
+			modify: func(msg *MsgCreateCampaign) {
+				msg.ClaimRecords = record(addr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)))
+			},
+			err: ErrInvalidCampaign,
+		}, {
+			name: "empty claim record",
+			modify: func(msg *MsgCreateCampaign) {
+				msg.ClaimRecords = record(addr, sdk.NewCoins())
+			},
+			err: ErrInvalidCampaign,
+		}, {
+			name:   "valid",
+			modify: func(msg *MsgCreateCampaign) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := valid()
+			tt.modify(&msg)
+			err := msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
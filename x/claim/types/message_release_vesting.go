@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReleaseVesting{}
+
+// msg types
+const (
+	TypeMsgReleaseVesting = "release_vesting"
+)
+
+func NewMsgReleaseVesting(sender string) *MsgReleaseVesting {
+	return &MsgReleaseVesting{
+		Sender: sender,
+	}
+}
+
+func (msg *MsgReleaseVesting) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReleaseVesting) Type() string {
+	return TypeMsgReleaseVesting
+}
+
+func (msg *MsgReleaseVesting) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgReleaseVesting) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReleaseVesting) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgTransferClaimRecord{}
+
+// msg types
+const (
+	TypeMsgTransferClaimRecord = "transfer_claim_record"
+)
+
+func NewMsgTransferClaimRecord(sender string, recipient string) *MsgTransferClaimRecord {
+	return &MsgTransferClaimRecord{
+		Sender:    sender,
+		Recipient: recipient,
+	}
+}
+
+func (msg *MsgTransferClaimRecord) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferClaimRecord) Type() string {
+	return TypeMsgTransferClaimRecord
+}
+
+func (msg *MsgTransferClaimRecord) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgTransferClaimRecord) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferClaimRecord) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if msg.Sender == msg.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender and recipient must differ")
+	}
+	return nil
+}
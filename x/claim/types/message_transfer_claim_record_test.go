@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/public-awesome/stargaze/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferClaimRecord_ValidateBasic(t *testing.T) {
+	addr := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgTransferClaimRecord
+		err  error
+	}{
+		{
+			name: "invalid sender",
+			msg: MsgTransferClaimRecord{
+				Sender:    "invalid_address",
+				Recipient: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient",
+			msg: MsgTransferClaimRecord{
+				Sender:    sample.AccAddress(),
+				Recipient: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "transfer to self",
+			msg: MsgTransferClaimRecord{
+				Sender:    addr,
+				Recipient: addr,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgTransferClaimRecord{
+				Sender:    sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
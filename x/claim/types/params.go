@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	yaml "gopkg.in/yaml.v2"
 )
@@ -23,6 +24,13 @@ var (
 	KeyDurationUntilDecal = []byte("DurationUntilDecay")
 	KeyDurationOfDecay    = []byte("DurationOfDecay")
 	KeyAllowedClaimers    = []byte("AllowedClaimers")
+	KeyActionWeights      = []byte("ActionWeights")
+	KeyDecayFunction      = []byte("DecayFunction")
+	KeyCampaignCreators   = []byte("CampaignCreators")
+	KeyVestingFraction    = []byte("VestingFraction")
+	KeyVestingDuration    = []byte("VestingDuration")
+	KeyDisableTransfer    = []byte("DisableTransferAfterClaim")
+	KeyStartHeight        = []byte("AirdropStartHeight")
 )
 
 func NewParams(enabled bool, claimDenom string, startTime time.Time, durationUntilDecay, durationOfDecay time.Duration, allowedClaimers []ClaimAuthorization) Params {
@@ -54,6 +62,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDurationUntilDecal, &p.DurationUntilDecay, validateDuration),
 		paramtypes.NewParamSetPair(KeyDurationOfDecay, &p.DurationOfDecay, validateDuration),
 		paramtypes.NewParamSetPair(KeyAllowedClaimers, &p.AllowedClaimers, validateClaimers),
+		paramtypes.NewParamSetPair(KeyActionWeights, &p.ActionWeights, validateActionWeights),
+		paramtypes.NewParamSetPair(KeyDecayFunction, &p.DecayFunction, validateDecayFunction),
+		paramtypes.NewParamSetPair(KeyCampaignCreators, &p.CampaignCreators, validateCampaignCreators),
+		paramtypes.NewParamSetPair(KeyVestingFraction, &p.VestingFraction, validateVestingFraction),
+		paramtypes.NewParamSetPair(KeyVestingDuration, &p.VestingDuration, validateVestingDuration),
+		paramtypes.NewParamSetPair(KeyDisableTransfer, &p.DisableTransferAfterClaim, validateEnabled),
+		paramtypes.NewParamSetPair(KeyStartHeight, &p.AirdropStartHeight, validateStartHeight),
 	}
 }
 
@@ -62,14 +77,79 @@ func (p Params) Validate() error {
 	if err := validateEnabled(p.AirdropEnabled); err != nil {
 		return err
 	}
-	err := validateDenom(p.ClaimDenom)
-	return err
+	if err := validateDenom(p.ClaimDenom); err != nil {
+		return err
+	}
+	if err := validateClaimers(p.AllowedClaimers); err != nil {
+		return err
+	}
+	if err := validateActionWeights(p.ActionWeights); err != nil {
+		return err
+	}
+	if err := validateDecayFunction(p.DecayFunction); err != nil {
+		return err
+	}
+	if err := validateCampaignCreators(p.CampaignCreators); err != nil {
+		return err
+	}
+	if err := validateVestingFraction(p.VestingFraction); err != nil {
+		return err
+	}
+	if err := validateVestingDuration(p.VestingDuration); err != nil {
+		return err
+	}
+	if err := validateStartHeight(p.AirdropStartHeight); err != nil {
+		return err
+	}
+	if p.HasVesting() && p.VestingDuration == 0 {
+		return fmt.Errorf("vesting duration must be positive when the vesting fraction is set")
+	}
+	return nil
+}
+
+// HasVesting returns true if part of every claim vests
+func (p Params) HasVesting() bool {
+	return !p.VestingFraction.IsNil() && p.VestingFraction.IsPositive()
+}
+
+// SplitVesting splits a claimed amount between its liquid and vesting part
+func (p Params) SplitVesting(amount sdk.Coins) (liquid, vesting sdk.Coins) {
+	if !p.HasVesting() {
+		return amount, sdk.Coins{}
+	}
+	vesting = sdk.Coins{}
+	for _, coin := range amount {
+		vesting = vesting.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(p.VestingFraction).TruncateInt()))
+	}
+	return amount.Sub(vesting), vesting
+}
+
+// DefaultActionWeights splits the initial claimable amount evenly between all actions
+func DefaultActionWeights() []ActionWeight {
+	weights := make([]ActionWeight, len(Action_name))
+	weight := sdk.OneDec().QuoInt64(int64(len(Action_name)))
+	for i := range weights {
+		weights[i] = ActionWeight{Action: Action(i), Weight: weight}
+	}
+	return weights
+}
+
+// EffectiveActionWeights returns the action weight table, falling back to an even split
+// between all actions when no table is set
+func (p Params) EffectiveActionWeights() []ActionWeight {
+	if len(p.ActionWeights) == 0 {
+		return DefaultActionWeights()
+	}
+	return p.ActionWeights
 }
 
 func (p Params) IsAirdropEnabled(t time.Time) bool {
 	if !p.AirdropEnabled {
 		return false
 	}
+	if p.IsAirdropStartPending() {
+		return false
+	}
 	if p.AirdropStartTime.IsZero() {
 		return false
 	}
@@ -79,6 +159,52 @@ func (p Params) IsAirdropEnabled(t time.Time) bool {
 	return true
 }
 
+// IsAirdropStartPending returns true if the airdrop waits for its start height
+func (p Params) IsAirdropStartPending() bool {
+	return p.AirdropStartHeight > 0
+}
+
+// IsAirdropStartHeightReached returns true if the airdrop waits for its start height and it is reached
+func (p Params) IsAirdropStartHeightReached(height int64) bool {
+	return p.IsAirdropStartPending() && height >= p.AirdropStartHeight
+}
+
+// AirdropStage returns the lifecycle stage of the airdrop at the given time
+func (p Params) AirdropStage(t time.Time) AirdropStage {
+	if !p.IsAirdropEnabled(t) {
+		return AirdropStageNotStarted
+	}
+	elapsed := t.Sub(p.AirdropStartTime)
+	switch {
+	case elapsed <= p.DurationUntilDecay:
+		return AirdropStageActive
+	case elapsed <= p.DurationUntilDecay+p.DurationOfDecay:
+		return AirdropStageDecaying
+	default:
+		return AirdropStageEnded
+	}
+}
+
+// IsAllowedClaimer returns true if the address is allowed to claim the action on behalf of other addresses
+func (p Params) IsAllowedClaimer(address string, action Action) bool {
+	for _, claimer := range p.AllowedClaimers {
+		if claimer.ContractAddress == address && claimer.Action == action {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCampaignCreator returns true if the address is allowed to create campaigns
+func (p Params) IsCampaignCreator(address string) bool {
+	for _, creator := range p.CampaignCreators {
+		if creator == address {
+			return true
+		}
+	}
+	return false
+}
+
 // ParamKeyTable for staking module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -125,9 +251,97 @@ func validateDuration(i interface{}) error {
 }
 
 func validateClaimers(i interface{}) error {
-	_, ok := i.([]ClaimAuthorization)
+	claimers, ok := i.([]ClaimAuthorization)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, claimer := range claimers {
+		if _, err := sdk.AccAddressFromBech32(claimer.ContractAddress); err != nil {
+			return fmt.Errorf("invalid claimer address %s: %w", claimer.ContractAddress, err)
+		}
+		if _, ok := Action_name[int32(claimer.Action)]; !ok {
+			return fmt.Errorf("invalid claimer action: %d", claimer.Action)
+		}
+	}
+	return nil
+}
+
+func validateActionWeights(i interface{}) error {
+	weights, ok := i.([]ActionWeight)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// an empty table splits evenly between all actions
+	if len(weights) == 0 {
+		return nil
+	}
+	seen := make(map[Action]bool)
+	total := sdk.ZeroDec()
+	for _, w := range weights {
+		if _, ok := Action_name[int32(w.Action)]; !ok {
+			return fmt.Errorf("invalid action weight action: %d", w.Action)
+		}
+		if seen[w.Action] {
+			return fmt.Errorf("duplicate action weight for %s", w.Action)
+		}
+		seen[w.Action] = true
+		if w.Weight.IsNil() || w.Weight.IsNegative() {
+			return fmt.Errorf("action weight for %s must not be negative", w.Action)
+		}
+		total = total.Add(w.Weight)
+	}
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("action weights must sum to 1: %s", total)
+	}
+	return nil
+}
+
+func validateCampaignCreators(i interface{}) error {
+	creators, ok := i.([]string)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	for _, creator := range creators {
+		if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+			return fmt.Errorf("invalid campaign creator address %s: %w", creator, err)
+		}
+	}
+	return nil
+}
+
+func validateVestingFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		// unset, nothing vests
+		return nil
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("vesting fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateVestingDuration(i interface{}) error {
+	d, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if d < 0 {
+		return fmt.Errorf("vesting duration cannot be negative: %d", d)
+	}
+	return nil
+}
+
+func validateStartHeight(i interface{}) error {
+	h, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if h < 0 {
+		return fmt.Errorf("airdrop start height cannot be negative: %d", h)
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -28,6 +29,37 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type DecayType int32
+
+const (
+	// claimable amount decreases linearly to zero over the decay duration
+	DecayTypeLinear DecayType = 0
+	// claimable amount drops in equal steps over the decay duration
+	DecayTypeStep DecayType = 1
+	// claimable amount halves every half life until the end of the decay duration
+	DecayTypeExponential DecayType = 2
+)
+
+var DecayType_name = map[int32]string{
+	0: "DecayTypeLinear",
+	1: "DecayTypeStep",
+	2: "DecayTypeExponential",
+}
+
+var DecayType_value = map[string]int32{
+	"DecayTypeLinear":      0,
+	"DecayTypeStep":        1,
+	"DecayTypeExponential": 2,
+}
+
+func (x DecayType) String() string {
+	return proto.EnumName(DecayType_name, int32(x))
+}
+
+func (DecayType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c219c2c72539a013, []int{0}
+}
+
 type ClaimAuthorization struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
 	Action          Action `protobuf:"varint,2,opt,name=action,proto3,enum=publicawesome.stargaze.claim.v1beta1.Action" json:"action,omitempty" yaml:"action"`
@@ -80,6 +112,115 @@ func (m *ClaimAuthorization) GetAction() Action {
 	return ActionInitialClaim
 }
 
+// DecayFunction defines how the claimable amount decays after DurationUntilDecay
+type DecayFunction struct {
+	Type DecayType `protobuf:"varint,1,opt,name=type,proto3,enum=publicawesome.stargaze.claim.v1beta1.DecayType" json:"type,omitempty" yaml:"type"`
+	// number of equal drops for step decay
+	Steps uint64 `protobuf:"varint,2,opt,name=steps,proto3" json:"steps,omitempty" yaml:"steps"`
+	// half life for exponential decay
+	HalfLife time.Duration `protobuf:"bytes,3,opt,name=half_life,json=halfLife,proto3,stdduration" json:"half_life,omitempty" yaml:"half_life"`
+}
+
+func (m *DecayFunction) Reset()         { *m = DecayFunction{} }
+func (m *DecayFunction) String() string { return proto.CompactTextString(m) }
+func (*DecayFunction) ProtoMessage()    {}
+func (*DecayFunction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c219c2c72539a013, []int{1}
+}
+func (m *DecayFunction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecayFunction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecayFunction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecayFunction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecayFunction.Merge(m, src)
+}
+func (m *DecayFunction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecayFunction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecayFunction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecayFunction proto.InternalMessageInfo
+
+func (m *DecayFunction) GetType() DecayType {
+	if m != nil {
+		return m.Type
+	}
+	return DecayTypeLinear
+}
+
+func (m *DecayFunction) GetSteps() uint64 {
+	if m != nil {
+		return m.Steps
+	}
+	return 0
+}
+
+func (m *DecayFunction) GetHalfLife() time.Duration {
+	if m != nil {
+		return m.HalfLife
+	}
+	return 0
+}
+
+// ActionWeight defines the share of the initial claimable amount for an action
+type ActionWeight struct {
+	Action Action                                 `protobuf:"varint,1,opt,name=action,proto3,enum=publicawesome.stargaze.claim.v1beta1.Action" json:"action,omitempty" yaml:"action"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *ActionWeight) Reset()         { *m = ActionWeight{} }
+func (m *ActionWeight) String() string { return proto.CompactTextString(m) }
+func (*ActionWeight) ProtoMessage()    {}
+func (*ActionWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c219c2c72539a013, []int{2}
+}
+func (m *ActionWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionWeight.Merge(m, src)
+}
+func (m *ActionWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionWeight proto.InternalMessageInfo
+
+func (m *ActionWeight) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return ActionInitialClaim
+}
+
 // Params defines the claim module's parameters.
 type Params struct {
 	AirdropEnabled     bool          `protobuf:"varint,1,opt,name=airdrop_enabled,json=airdropEnabled,proto3" json:"airdrop_enabled,omitempty"`
@@ -90,12 +231,33 @@ type Params struct {
 	ClaimDenom string `protobuf:"bytes,5,opt,name=claim_denom,json=claimDenom,proto3" json:"claim_denom,omitempty"`
 	// list of contracts and their allowed claim actions
 	AllowedClaimers []ClaimAuthorization `protobuf:"bytes,6,rep,name=allowed_claimers,json=allowedClaimers,proto3" json:"allowed_claimers" yaml:"allowed_claimers"`
+	// share of the initial claimable amount for every action, must sum to 1.
+	// the rounding remainder goes to the last action of the table.
+	// an empty table splits the initial claimable amount evenly between all actions.
+	ActionWeights []ActionWeight `protobuf:"bytes,7,rep,name=action_weights,json=actionWeights,proto3" json:"action_weights" yaml:"action_weights"`
+	// decay curve applied during DurationOfDecay, linear by default
+	DecayFunction DecayFunction `protobuf:"bytes,8,opt,name=decay_function,json=decayFunction,proto3" json:"decay_function" yaml:"decay_function"`
+	// addresses allowed to create airdrop campaigns funded from their own
+	// account with MsgCreateCampaign. governance only controls this allowlist
+	// through param change proposals; campaigns funded from the community pool
+	// are created by a CreateCampaignProposal.
+	CampaignCreators []string `protobuf:"bytes,9,rep,name=campaign_creators,json=campaignCreators,proto3" json:"campaign_creators" yaml:"campaign_creators"`
+	// fraction of every claim that vests continuously over vesting_duration
+	// instead of being sent liquid
+	VestingFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=vesting_fraction,json=vestingFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vesting_fraction" yaml:"vesting_fraction"`
+	VestingDuration time.Duration                          `protobuf:"bytes,11,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration,omitempty" yaml:"vesting_duration"`
+	// reject claim record transfers once any action of the record is completed
+	DisableTransferAfterClaim bool `protobuf:"varint,12,opt,name=disable_transfer_after_claim,json=disableTransferAfterClaim,proto3" json:"disable_transfer_after_claim,omitempty" yaml:"disable_transfer_after_claim"`
+	// block height starting the airdrop, takes priority over the start time when
+	// set. Once reached, the start time is set to the block time and the start
+	// height is reset to zero.
+	AirdropStartHeight int64 `protobuf:"varint,13,opt,name=airdrop_start_height,json=airdropStartHeight,proto3" json:"airdrop_start_height,omitempty" yaml:"airdrop_start_height"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c219c2c72539a013, []int{1}
+	return fileDescriptor_c219c2c72539a013, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,8 +328,53 @@ func (m *Params) GetAllowedClaimers() []ClaimAuthorization {
 	return nil
 }
 
+func (m *Params) GetActionWeights() []ActionWeight {
+	if m != nil {
+		return m.ActionWeights
+	}
+	return nil
+}
+
+func (m *Params) GetDecayFunction() DecayFunction {
+	if m != nil {
+		return m.DecayFunction
+	}
+	return DecayFunction{}
+}
+
+func (m *Params) GetCampaignCreators() []string {
+	if m != nil {
+		return m.CampaignCreators
+	}
+	return nil
+}
+
+func (m *Params) GetVestingDuration() time.Duration {
+	if m != nil {
+		return m.VestingDuration
+	}
+	return 0
+}
+
+func (m *Params) GetDisableTransferAfterClaim() bool {
+	if m != nil {
+		return m.DisableTransferAfterClaim
+	}
+	return false
+}
+
+func (m *Params) GetAirdropStartHeight() int64 {
+	if m != nil {
+		return m.AirdropStartHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("publicawesome.stargaze.claim.v1beta1.DecayType", DecayType_name, DecayType_value)
 	proto.RegisterType((*ClaimAuthorization)(nil), "publicawesome.stargaze.claim.v1beta1.ClaimAuthorization")
+	proto.RegisterType((*DecayFunction)(nil), "publicawesome.stargaze.claim.v1beta1.DecayFunction")
+	proto.RegisterType((*ActionWeight)(nil), "publicawesome.stargaze.claim.v1beta1.ActionWeight")
 	proto.RegisterType((*Params)(nil), "publicawesome.stargaze.claim.v1beta1.Params")
 }
 
@@ -176,43 +383,72 @@ func init() {
 }
 
 var fileDescriptor_c219c2c72539a013 = []byte{
-	// 571 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0x6e, 0xd3, 0x50,
-	0x14, 0xc6, 0x63, 0xda, 0x46, 0x70, 0x23, 0x9a, 0xd4, 0xaa, 0x84, 0x9b, 0x48, 0x76, 0x64, 0x40,
-	0x04, 0xa9, 0xd8, 0x34, 0x5d, 0x50, 0xb7, 0x38, 0x01, 0x09, 0x16, 0x90, 0x01, 0x21, 0xb1, 0x58,
-	0xd7, 0xf6, 0x8d, 0x6b, 0xc9, 0xce, 0xb5, 0xae, 0xaf, 0x29, 0xe9, 0x23, 0x74, 0xea, 0x84, 0x32,
-	0xf2, 0x12, 0xbc, 0x43, 0xc7, 0x8e, 0x4c, 0x06, 0x25, 0x1b, 0x63, 0x9e, 0x00, 0xdd, 0x3f, 0x2e,
-	0x22, 0x29, 0xa2, 0x5b, 0xfc, 0x9d, 0xf3, 0x9d, 0xf3, 0xcb, 0xe7, 0x63, 0x70, 0x3f, 0xa7, 0x90,
-	0x44, 0xf0, 0x14, 0xd9, 0x41, 0x02, 0xe3, 0xd4, 0xfe, 0x74, 0xe0, 0x23, 0x0a, 0x0f, 0xec, 0x0c,
-	0x12, 0x98, 0xe6, 0x56, 0x46, 0x30, 0xc5, 0xea, 0x83, 0xac, 0xf0, 0x93, 0x38, 0x80, 0x27, 0x28,
-	0xc7, 0x29, 0xb2, 0x2a, 0x8b, 0xc5, 0x2d, 0x96, 0xb4, 0xb4, 0x77, 0x23, 0x1c, 0x61, 0x6e, 0xb0,
-	0xd9, 0x2f, 0xe1, 0x6d, 0xeb, 0x11, 0xc6, 0x51, 0x82, 0x6c, 0xfe, 0xe4, 0x17, 0x63, 0x3b, 0x2c,
-	0x08, 0xa4, 0x31, 0x9e, 0xc8, 0xba, 0xb1, 0x5a, 0xa7, 0x71, 0x8a, 0x72, 0x0a, 0xd3, 0x4c, 0x36,
-	0x3c, 0xfe, 0x07, 0x21, 0x7f, 0xf2, 0x08, 0x0a, 0x30, 0x09, 0x45, 0xab, 0xf9, 0x4d, 0x01, 0xea,
-	0x90, 0xc9, 0x83, 0x82, 0x1e, 0x63, 0x12, 0x9f, 0xf2, 0x45, 0xea, 0x0b, 0xd0, 0x0a, 0xf0, 0x84,
-	0x12, 0x18, 0x50, 0x0f, 0x86, 0x21, 0x41, 0x79, 0xae, 0x29, 0x5d, 0xa5, 0x77, 0xc7, 0xe9, 0x2c,
-	0x4b, 0xe3, 0xde, 0x14, 0xa6, 0xc9, 0x91, 0xb9, 0xda, 0x61, 0xba, 0xcd, 0x4a, 0x1a, 0x08, 0x45,
-	0xfd, 0x00, 0xea, 0x30, 0x60, 0x13, 0xb5, 0x5b, 0x5d, 0xa5, 0xb7, 0xdd, 0xdf, 0xb7, 0x6e, 0x92,
-	0x8b, 0x35, 0xe0, 0x1e, 0x67, 0x67, 0x59, 0x1a, 0x77, 0xc5, 0x2e, 0x31, 0xc5, 0x74, 0xe5, 0x38,
-	0xf3, 0x6c, 0x0b, 0xd4, 0xdf, 0xf0, 0xc0, 0xd5, 0x47, 0xa0, 0x09, 0x63, 0x12, 0x12, 0x9c, 0x79,
-	0x68, 0x02, 0xfd, 0x04, 0x85, 0x1c, 0xf5, 0xb6, 0xbb, 0x2d, 0xe5, 0xe7, 0x42, 0x55, 0x31, 0x50,
-	0xab, 0x46, 0xb6, 0x97, 0x7a, 0x2c, 0x37, 0x0e, 0xd6, 0xe8, 0xb7, 0x2d, 0x11, 0xaa, 0x55, 0x85,
-	0x6a, 0xbd, 0xab, 0x42, 0x75, 0x1e, 0x5e, 0x94, 0x46, 0x6d, 0x59, 0x1a, 0x7b, 0x12, 0x65, 0x6d,
-	0x86, 0x79, 0xfe, 0xc3, 0x50, 0xdc, 0x96, 0x2c, 0xbc, 0x65, 0x3a, 0x73, 0xab, 0x5f, 0x14, 0xb0,
-	0x5b, 0xbd, 0x3b, 0xaf, 0x98, 0xd0, 0x38, 0xf1, 0x42, 0x14, 0xc0, 0xa9, 0xb6, 0xc1, 0x77, 0xee,
-	0xad, 0xed, 0x1c, 0xc9, 0x66, 0xe7, 0x25, 0x5b, 0xf9, 0xab, 0x34, 0xf4, 0xeb, 0xec, 0xfb, 0x38,
-	0x8d, 0x29, 0x4a, 0x33, 0x3a, 0x5d, 0x96, 0x46, 0x47, 0x40, 0x5d, 0xd7, 0x67, 0xce, 0x18, 0x96,
-	0x5a, 0x95, 0xde, 0xb3, 0xca, 0x88, 0x15, 0xd4, 0x33, 0x05, 0xec, 0x5c, 0x39, 0xf0, 0x58, 0x52,
-	0x6d, 0xfe, 0x8f, 0x6a, 0x28, 0xa9, 0x3a, 0x6b, 0xde, 0xbf, 0x90, 0xb4, 0x15, 0xa4, 0xaa, 0x49,
-	0xf0, 0x34, 0x2b, 0xfd, 0xf5, 0x58, 0xc0, 0x18, 0xa0, 0x21, 0x0e, 0x33, 0x44, 0x13, 0x9c, 0x6a,
-	0x5b, 0xec, 0xcc, 0x5c, 0xc0, 0xa5, 0x11, 0x53, 0xd4, 0x99, 0x02, 0x5a, 0x30, 0x49, 0xf0, 0x09,
-	0x0a, 0x3d, 0x2e, 0x23, 0x92, 0x6b, 0xf5, 0xee, 0x46, 0xaf, 0xd1, 0x7f, 0x76, 0xb3, 0x7b, 0x5a,
-	0xbf, 0x70, 0xe7, 0x50, 0xfe, 0x97, 0xb5, 0xc9, 0x7f, 0xee, 0x7b, 0xb5, 0x62, 0xba, 0x4d, 0x29,
-	0x0d, 0xa5, 0x72, 0xb4, 0x39, 0xfb, 0x6a, 0xd4, 0x9c, 0x57, 0x17, 0x73, 0x5d, 0xb9, 0x9c, 0xeb,
-	0xca, 0xcf, 0xb9, 0xae, 0x9c, 0x2f, 0xf4, 0xda, 0xe5, 0x42, 0xaf, 0x7d, 0x5f, 0xe8, 0xb5, 0x8f,
-	0x4f, 0xa3, 0x98, 0x1e, 0x17, 0xbe, 0x15, 0xe0, 0xd4, 0x16, 0xa4, 0x4f, 0x24, 0xaa, 0x7d, 0xf5,
-	0x8d, 0x7e, 0x96, 0x5f, 0x29, 0x9d, 0x66, 0x28, 0xf7, 0xeb, 0x3c, 0xf6, 0xc3, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xf1, 0x4e, 0xdb, 0xe4, 0x66, 0x04, 0x00, 0x00,
+	// 1030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcb, 0x6f, 0xdc, 0x44,
+	0x1c, 0x5e, 0x37, 0x69, 0xc8, 0xce, 0x66, 0xb3, 0xce, 0x34, 0x08, 0x27, 0x81, 0xf5, 0xca, 0x85,
+	0x76, 0x41, 0xad, 0x4d, 0x36, 0x17, 0xd4, 0x0b, 0x8a, 0x93, 0x46, 0x14, 0x55, 0x02, 0x9c, 0xa0,
+	0x4a, 0x1c, 0xb0, 0x66, 0xed, 0xf1, 0xae, 0x55, 0xdb, 0x63, 0xd9, 0xb3, 0x4d, 0xb7, 0xff, 0x00,
+	0x88, 0x53, 0x4f, 0x28, 0x47, 0x24, 0xfe, 0x00, 0x4e, 0xdc, 0x39, 0xf6, 0xd8, 0x23, 0xe2, 0x60,
+	0x50, 0x72, 0xeb, 0x71, 0x8f, 0x9c, 0xd0, 0x3c, 0xbc, 0xd9, 0x47, 0x20, 0x89, 0xc4, 0x29, 0x99,
+	0xef, 0xf7, 0x98, 0x6f, 0x7e, 0x8f, 0x6f, 0x0d, 0x6e, 0xe7, 0x14, 0x65, 0x3d, 0xf4, 0x02, 0x5b,
+	0x5e, 0x84, 0xc2, 0xd8, 0x7a, 0xb6, 0xdd, 0xc5, 0x14, 0x6d, 0x5b, 0x29, 0xca, 0x50, 0x9c, 0x9b,
+	0x69, 0x46, 0x28, 0x81, 0xef, 0xa7, 0x83, 0x6e, 0x14, 0x7a, 0xe8, 0x18, 0xe7, 0x24, 0xc6, 0x66,
+	0x19, 0x62, 0xf2, 0x10, 0x53, 0x86, 0x6c, 0xae, 0xf7, 0x48, 0x8f, 0xf0, 0x00, 0x8b, 0xfd, 0x27,
+	0x62, 0x37, 0x9b, 0x3d, 0x42, 0x7a, 0x11, 0xb6, 0xf8, 0xa9, 0x3b, 0x08, 0x2c, 0x7f, 0x90, 0x21,
+	0x1a, 0x92, 0x44, 0xda, 0xf5, 0x59, 0x3b, 0x0d, 0x63, 0x9c, 0x53, 0x14, 0xa7, 0xd2, 0xe1, 0xc3,
+	0x7f, 0x61, 0xc8, 0x4f, 0x6e, 0x86, 0x3d, 0x92, 0xf9, 0xc2, 0xd5, 0xf8, 0x55, 0x01, 0x70, 0x8f,
+	0xc1, 0xbb, 0x03, 0xda, 0x27, 0x59, 0xf8, 0x82, 0x5f, 0x04, 0x0f, 0x80, 0xea, 0x91, 0x84, 0x66,
+	0xc8, 0xa3, 0x2e, 0xf2, 0xfd, 0x0c, 0xe7, 0xb9, 0xa6, 0xb4, 0x94, 0x76, 0xd5, 0xde, 0x1a, 0x15,
+	0xfa, 0x3b, 0x43, 0x14, 0x47, 0x0f, 0x8c, 0x59, 0x0f, 0xc3, 0x69, 0x94, 0xd0, 0xae, 0x40, 0xe0,
+	0x13, 0xb0, 0x84, 0x3c, 0x96, 0x51, 0xbb, 0xd1, 0x52, 0xda, 0xab, 0x9d, 0x7b, 0xe6, 0x55, 0xea,
+	0x62, 0xee, 0xf2, 0x18, 0x7b, 0x6d, 0x54, 0xe8, 0x75, 0x71, 0x97, 0xc8, 0x62, 0x38, 0x32, 0x9d,
+	0xf1, 0xb7, 0x02, 0xea, 0xfb, 0xd8, 0x43, 0xc3, 0x83, 0x41, 0xc2, 0x11, 0x78, 0x04, 0x16, 0xe9,
+	0x30, 0xc5, 0x9c, 0xe6, 0x6a, 0xc7, 0xba, 0xda, 0x45, 0x3c, 0xc5, 0xd1, 0x30, 0xc5, 0x76, 0x63,
+	0x54, 0xe8, 0x35, 0x71, 0x17, 0x4b, 0x63, 0x38, 0x3c, 0x1b, 0xbc, 0x03, 0x6e, 0xe6, 0x14, 0xa7,
+	0x39, 0xe7, 0xbf, 0x68, 0xab, 0xa3, 0x42, 0x5f, 0x11, 0x5e, 0x1c, 0x36, 0x1c, 0x61, 0x86, 0x4f,
+	0x41, 0xb5, 0x8f, 0xa2, 0xc0, 0x8d, 0xc2, 0x00, 0x6b, 0x0b, 0x2d, 0xa5, 0x5d, 0xeb, 0x6c, 0x98,
+	0xa2, 0x4f, 0x66, 0xd9, 0x27, 0x73, 0x5f, 0xf6, 0xd1, 0xde, 0x79, 0x55, 0xe8, 0x95, 0x37, 0x85,
+	0x7e, 0x6b, 0x1c, 0x73, 0x8f, 0xc4, 0x21, 0xc5, 0x71, 0x4a, 0x87, 0xa3, 0x42, 0x57, 0xc5, 0x0d,
+	0x63, 0xa3, 0x71, 0xf2, 0xa7, 0xae, 0x38, 0xcb, 0xec, 0xfc, 0x98, 0x1d, 0x7f, 0x53, 0xc0, 0x8a,
+	0x28, 0xd1, 0x13, 0x1c, 0xf6, 0xfa, 0x74, 0xa2, 0xcc, 0xca, 0xff, 0x5a, 0x66, 0x96, 0xf8, 0x98,
+	0x5f, 0xc1, 0xdf, 0x5f, 0xb5, 0x3f, 0x65, 0xc4, 0xff, 0x28, 0xf4, 0x3b, 0xbd, 0x90, 0xf6, 0x07,
+	0x5d, 0xd3, 0x23, 0xb1, 0xe5, 0x91, 0x3c, 0x26, 0xb9, 0xfc, 0x73, 0x3f, 0xf7, 0x9f, 0x5a, 0xac,
+	0x72, 0x39, 0xab, 0xed, 0x79, 0x62, 0x91, 0xc5, 0x70, 0x64, 0x3a, 0xe3, 0x97, 0x1a, 0x58, 0xfa,
+	0x92, 0x2f, 0x0c, 0xbc, 0x0b, 0x1a, 0x28, 0xcc, 0xfc, 0x8c, 0xa4, 0x2e, 0x4e, 0x50, 0x37, 0xc2,
+	0x3e, 0x7f, 0xc5, 0xb2, 0xb3, 0x2a, 0xe1, 0x87, 0x02, 0x85, 0x04, 0xc0, 0xd2, 0x91, 0x3d, 0x88,
+	0xba, 0x6c, 0xee, 0x39, 0xb1, 0x5a, 0x67, 0x73, 0xae, 0xd8, 0x47, 0xe5, 0x52, 0xd8, 0x1f, 0x30,
+	0xd2, 0xa3, 0x42, 0xdf, 0x90, 0x6f, 0x9c, 0xcb, 0x61, 0xbc, 0x64, 0xf5, 0x55, 0xa5, 0xe1, 0x90,
+	0xe1, 0x2c, 0x1a, 0xfe, 0xa8, 0x80, 0xf5, 0x72, 0xf7, 0xdc, 0x41, 0x42, 0xc3, 0xc8, 0xf5, 0xd9,
+	0xc0, 0x5c, 0xde, 0xe0, 0x47, 0xb2, 0xc1, 0xcd, 0x8b, 0xc2, 0xa7, 0x7a, 0xbd, 0x25, 0x48, 0x5d,
+	0xe4, 0x27, 0xda, 0x0e, 0x4b, 0xd3, 0xd7, 0xcc, 0xc2, 0x07, 0x16, 0xfe, 0xa0, 0x80, 0xb5, 0x71,
+	0x04, 0x09, 0x24, 0xab, 0xc5, 0xcb, 0x58, 0xed, 0x49, 0x56, 0x5b, 0x73, 0xb1, 0x53, 0x94, 0xb4,
+	0x19, 0x4a, 0x24, 0x98, 0xe4, 0xd3, 0x28, 0xf1, 0x2f, 0x02, 0x41, 0x46, 0x07, 0x35, 0x21, 0x2c,
+	0x3e, 0x4e, 0x48, 0xac, 0xdd, 0x64, 0x83, 0xe2, 0x00, 0x0e, 0xed, 0x33, 0x04, 0x9e, 0x28, 0x40,
+	0x45, 0x51, 0x44, 0x8e, 0xb1, 0xef, 0x72, 0x18, 0x67, 0xb9, 0xb6, 0xd4, 0x5a, 0x68, 0xd7, 0x3a,
+	0x9f, 0x5c, 0x6d, 0x50, 0xe7, 0x15, 0x6a, 0xbc, 0x42, 0x73, 0x99, 0xcf, 0xf5, 0x69, 0xd6, 0x62,
+	0x38, 0x0d, 0x09, 0xed, 0x49, 0x84, 0x15, 0x72, 0x55, 0x8c, 0xba, 0x2b, 0x06, 0x33, 0xd7, 0xde,
+	0xe2, 0xc4, 0x3a, 0xd7, 0xd9, 0x20, 0xb1, 0x85, 0xb6, 0x25, 0x29, 0xcd, 0x64, 0x1c, 0x15, 0xfa,
+	0xdb, 0x93, 0xdb, 0x55, 0xe2, 0x86, 0x53, 0x47, 0x13, 0xe1, 0x39, 0x1c, 0x82, 0x55, 0x5e, 0x67,
+	0x37, 0x90, 0x9a, 0xa6, 0x2d, 0xf3, 0x8e, 0xee, 0x5c, 0x43, 0xcb, 0x4a, 0x39, 0xb4, 0xdf, 0x93,
+	0x43, 0x2f, 0xaf, 0x9e, 0x4e, 0x6c, 0x38, 0x75, 0x7f, 0x4a, 0x3c, 0xbf, 0x05, 0x6b, 0x1e, 0x8a,
+	0x53, 0x14, 0xf6, 0x12, 0xd7, 0xcb, 0x30, 0xa2, 0x24, 0xcb, 0xb5, 0x6a, 0x6b, 0xa1, 0x5d, 0xb5,
+	0xb7, 0xdf, 0x14, 0xfa, 0xbc, 0xf1, 0x7c, 0x4c, 0xe6, 0x4c, 0x86, 0xa3, 0x96, 0xd8, 0x9e, 0x84,
+	0x20, 0x05, 0xea, 0x33, 0x9c, 0xd3, 0x30, 0xe9, 0xb9, 0x41, 0x26, 0xa5, 0x0a, 0x70, 0x45, 0x79,
+	0x74, 0x6d, 0x45, 0x91, 0xdd, 0x9d, 0xcd, 0x67, 0x38, 0x0d, 0x09, 0x1d, 0x48, 0x04, 0x7e, 0xa7,
+	0x9c, 0x5f, 0x5b, 0x4e, 0xad, 0x56, 0xbb, 0x6c, 0x4b, 0x76, 0x65, 0x1b, 0x37, 0x67, 0x43, 0xa7,
+	0x96, 0x64, 0x86, 0x45, 0xe9, 0x23, 0x77, 0x44, 0xc2, 0x65, 0x4e, 0xd8, 0x07, 0xef, 0xfa, 0x61,
+	0xce, 0x64, 0xcc, 0xa5, 0x19, 0x4a, 0xf2, 0x00, 0x67, 0x2e, 0x0a, 0x28, 0xce, 0xc4, 0x70, 0x6a,
+	0x2b, 0x4c, 0xf0, 0xec, 0xbb, 0xa3, 0x42, 0xbf, 0x2d, 0xfb, 0xf5, 0x1f, 0xde, 0x86, 0xb3, 0x21,
+	0xcd, 0x47, 0xd2, 0xba, 0xcb, 0x8c, 0x7c, 0xa8, 0xe1, 0x57, 0x60, 0x7d, 0x5a, 0xe0, 0xfa, 0x42,
+	0xbf, 0xeb, 0x2d, 0xa5, 0xbd, 0x60, 0xeb, 0xe7, 0x8a, 0x73, 0x91, 0x97, 0xe1, 0xc0, 0x49, 0x11,
+	0xfc, 0x8c, 0x83, 0x0f, 0x16, 0x4f, 0x7e, 0xd2, 0x2b, 0x1f, 0x1d, 0x82, 0xea, 0xf8, 0xd7, 0x12,
+	0xde, 0x02, 0x8d, 0xf1, 0xe1, 0x71, 0x98, 0x60, 0x94, 0xa9, 0x15, 0xb8, 0x06, 0xea, 0x63, 0xf0,
+	0x90, 0xe2, 0x54, 0x55, 0xa0, 0x06, 0xd6, 0xc7, 0xd0, 0xc3, 0xe7, 0x29, 0x49, 0x70, 0x42, 0x43,
+	0x14, 0xa9, 0x37, 0x36, 0x17, 0xbf, 0xff, 0xb9, 0x59, 0xb1, 0x3f, 0x7f, 0x75, 0xda, 0x54, 0x5e,
+	0x9f, 0x36, 0x95, 0xbf, 0x4e, 0x9b, 0xca, 0xcb, 0xb3, 0x66, 0xe5, 0xf5, 0x59, 0xb3, 0xf2, 0xfb,
+	0x59, 0xb3, 0xf2, 0xcd, 0xc7, 0x13, 0xf3, 0x20, 0xc6, 0xff, 0xbe, 0x9c, 0x7f, 0x6b, 0xfc, 0x75,
+	0xf3, 0x5c, 0x7e, 0xdf, 0xf0, 0xe9, 0xe8, 0x2e, 0xf1, 0x56, 0xee, 0xfc, 0x33, 0x00, 0x79, 0xd1,
+	0xc1, 0x55, 0xa0, 0x09, 0x00, 0x00,
 }
 
 func (m *ClaimAuthorization) Marshal() (dAtA []byte, err error) {
@@ -250,6 +486,85 @@ func (m *ClaimAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DecayFunction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecayFunction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecayFunction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.HalfLife, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.HalfLife):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Steps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Steps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Action != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -270,6 +585,72 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AirdropStartHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AirdropStartHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DisableTransferAfterClaim {
+		i--
+		if m.DisableTransferAfterClaim {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.VestingFraction.Size()
+		i -= size
+		if _, err := m.VestingFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.CampaignCreators) > 0 {
+		for iNdEx := len(m.CampaignCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CampaignCreators[iNdEx])
+			copy(dAtA[i:], m.CampaignCreators[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.CampaignCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.DecayFunction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ActionWeights) > 0 {
+		for iNdEx := len(m.ActionWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActionWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.AllowedClaimers) > 0 {
 		for iNdEx := len(m.AllowedClaimers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -291,28 +672,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay):])
-	if err1 != nil {
-		return 0, err1
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AirdropStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AirdropStartTime):])
-	if err3 != nil {
-		return 0, err3
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AirdropStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AirdropStartTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n3
-	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if m.AirdropEnabled {
@@ -355,6 +736,37 @@ func (m *ClaimAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *DecayFunction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovParams(uint64(m.Type))
+	}
+	if m.Steps != 0 {
+		n += 1 + sovParams(uint64(m.Steps))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.HalfLife)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ActionWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovParams(uint64(m.Action))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -380,6 +792,30 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.ActionWeights) > 0 {
+		for _, e := range m.ActionWeights {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.DecayFunction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.CampaignCreators) > 0 {
+		for _, s := range m.CampaignCreators {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.VestingFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.DisableTransferAfterClaim {
+		n += 2
+	}
+	if m.AirdropStartHeight != 0 {
+		n += 1 + sovParams(uint64(m.AirdropStartHeight))
+	}
 	return n
 }
 
@@ -490,7 +926,7 @@ func (m *ClaimAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DecayFunction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -513,17 +949,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecayFunction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecayFunction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AirdropEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var v int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -533,15 +969,239 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Type |= DecayType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AirdropEnabled = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AirdropStartTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			m.Steps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Steps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfLife", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.HalfLife, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AirdropEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropStartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -704,6 +1364,211 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionWeights = append(m.ActionWeights, ActionWeight{})
+			if err := m.ActionWeights[len(m.ActionWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFunction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFunction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CampaignCreators = append(m.CampaignCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableTransferAfterClaim", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableTransferAfterClaim = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropStartHeight", wireType)
+			}
+			m.AirdropStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
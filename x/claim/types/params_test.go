@@ -0,0 +1,151 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateActionWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []ActionWeight
+		valid   bool
+	}{
+		{
+			name:    "empty table splits evenly",
+			weights: []ActionWeight{},
+			valid:   true,
+		},
+		{
+			name:    "default weights",
+			weights: DefaultActionWeights(),
+			valid:   true,
+		},
+		{
+			name: "partial table summing to one",
+			weights: []ActionWeight{
+				{Action: ActionInitialClaim, Weight: sdk.NewDecWithPrec(6, 1)},
+				{Action: ActionDelegateStake, Weight: sdk.NewDecWithPrec(4, 1)},
+			},
+			valid: true,
+		},
+		{
+			name: "sum below one",
+			weights: []ActionWeight{
+				{Action: ActionInitialClaim, Weight: sdk.NewDecWithPrec(6, 1)},
+			},
+		},
+		{
+			name: "sum above one",
+			weights: []ActionWeight{
+				{Action: ActionInitialClaim, Weight: sdk.NewDecWithPrec(6, 1)},
+				{Action: ActionVote, Weight: sdk.NewDecWithPrec(6, 1)},
+			},
+		},
+		{
+			name: "duplicate action",
+			weights: []ActionWeight{
+				{Action: ActionVote, Weight: sdk.NewDecWithPrec(5, 1)},
+				{Action: ActionVote, Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+		},
+		{
+			name: "negative weight",
+			weights: []ActionWeight{
+				{Action: ActionInitialClaim, Weight: sdk.NewDec(2)},
+				{Action: ActionVote, Weight: sdk.NewDec(-1)},
+			},
+		},
+		{
+			name: "invalid action",
+			weights: []ActionWeight{
+				{Action: Action(10), Weight: sdk.OneDec()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateActionWeights(tt.weights)
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestValidateVesting(t *testing.T) {
+	tests := []struct {
+		name     string
+		fraction sdk.Dec
+		duration time.Duration
+		valid    bool
+	}{
+		{
+			name:     "no vesting",
+			fraction: sdk.ZeroDec(),
+			valid:    true,
+		},
+		{
+			name:     "half vests",
+			fraction: sdk.NewDecWithPrec(5, 1),
+			duration: time.Hour,
+			valid:    true,
+		},
+		{
+			name:     "fraction without duration",
+			fraction: sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:     "negative fraction",
+			fraction: sdk.NewDec(-1),
+			duration: time.Hour,
+		},
+		{
+			name:     "fraction above one",
+			fraction: sdk.NewDec(2),
+			duration: time.Hour,
+		},
+		{
+			name:     "negative duration",
+			fraction: sdk.ZeroDec(),
+			duration: -time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.VestingFraction = tt.fraction
+			params.VestingDuration = tt.duration
+			err := params.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestAirdropStartHeight(t *testing.T) {
+	start := time.Now().UTC()
+	params := DefaultParams()
+	params.AirdropStartTime = start
+	require.True(t, params.IsAirdropEnabled(start))
+
+	// the start height takes priority over the start time
+	params.AirdropStartHeight = 10
+	require.NoError(t, params.Validate())
+	require.False(t, params.IsAirdropEnabled(start))
+	require.Equal(t, AirdropStageNotStarted, params.AirdropStage(start.Add(time.Minute)))
+	require.False(t, params.IsAirdropStartHeightReached(9))
+	require.True(t, params.IsAirdropStartHeightReached(10))
+	require.True(t, params.IsAirdropStartHeightReached(11))
+
+	params.AirdropStartHeight = -1
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,170 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeClaimRecordsUpdate defines the type for a ClaimRecordsUpdateProposal
+	ProposalTypeClaimRecordsUpdate = "ClaimRecordsUpdate"
+	// ProposalTypeCreateCampaign defines the type for a CreateCampaignProposal
+	ProposalTypeCreateCampaign = "CreateCampaign"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &ClaimRecordsUpdateProposal{}
+	_ govtypes.Content = &CreateCampaignProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeClaimRecordsUpdate)
+	govtypes.RegisterProposalTypeCodec(&ClaimRecordsUpdateProposal{}, "claim/ClaimRecordsUpdateProposal")
+	govtypes.RegisterProposalType(ProposalTypeCreateCampaign)
+	govtypes.RegisterProposalTypeCodec(&CreateCampaignProposal{}, "claim/CreateCampaignProposal")
+}
+
+// NewClaimRecordsUpdateProposal creates a new claim records update proposal.
+func NewClaimRecordsUpdateProposal(title, description string, claimRecords []ClaimRecord, revokedAddresses []string) *ClaimRecordsUpdateProposal {
+	return &ClaimRecordsUpdateProposal{title, description, claimRecords, revokedAddresses}
+}
+
+// GetTitle returns the title of a claim records update proposal.
+func (p *ClaimRecordsUpdateProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a claim records update proposal.
+func (p *ClaimRecordsUpdateProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a claim records update proposal.
+func (p *ClaimRecordsUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a claim records update proposal.
+func (p *ClaimRecordsUpdateProposal) ProposalType() string { return ProposalTypeClaimRecordsUpdate }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ClaimRecordsUpdateProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.ClaimRecords) == 0 && len(p.RevokedAddresses) == 0 {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "no claim records to update or revoke")
+	}
+	seen := make(map[string]bool)
+	for _, record := range p.ClaimRecords {
+		if _, err := sdk.AccAddressFromBech32(record.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claim record address (%s)", err)
+		}
+		if seen[record.Address] {
+			return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "duplicate address %s", record.Address)
+		}
+		seen[record.Address] = true
+		if !record.InitialClaimableAmount.IsValid() || record.InitialClaimableAmount.Empty() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "claim record of %s must be positive: %s", record.Address, record.InitialClaimableAmount)
+		}
+	}
+	for _, address := range p.RevokedAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid revoked address (%s)", err)
+		}
+		if seen[address] {
+			return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "duplicate address %s", address)
+		}
+		seen[address] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ClaimRecordsUpdateProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Claim Records Update Proposal:
+  Title:       %s
+  Description: %s
+  Claim Records:
+`, p.Title, p.Description))
+	for _, record := range p.ClaimRecords {
+		b.WriteString(fmt.Sprintf("    %s: %s\n", record.Address, record.InitialClaimableAmount))
+	}
+	b.WriteString("  Revoked Addresses:\n")
+	for _, address := range p.RevokedAddresses {
+		b.WriteString(fmt.Sprintf("    %s\n", address))
+	}
+	return b.String()
+}
+
+// NewCreateCampaignProposal creates a new proposal to create a campaign funded from the community pool.
+func NewCreateCampaignProposal(
+	title, description string,
+	name string,
+	denom string,
+	startTime time.Time,
+	durationUntilDecay, durationOfDecay time.Duration,
+	decay DecayFunction,
+	actionWeights []ActionWeight,
+	claimRecords []ClaimRecord,
+) *CreateCampaignProposal {
+	return &CreateCampaignProposal{
+		Title:              title,
+		Description:        description,
+		Name:               name,
+		Denom:              denom,
+		StartTime:          startTime,
+		DurationUntilDecay: durationUntilDecay,
+		DurationOfDecay:    durationOfDecay,
+		DecayFunction:      decay,
+		ActionWeights:      actionWeights,
+		ClaimRecords:       claimRecords,
+	}
+}
+
+// GetTitle returns the title of a create campaign proposal.
+func (p *CreateCampaignProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a create campaign proposal.
+func (p *CreateCampaignProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a create campaign proposal.
+func (p *CreateCampaignProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a create campaign proposal.
+func (p *CreateCampaignProposal) ProposalType() string { return ProposalTypeCreateCampaign }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CreateCampaignProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return validateCampaign(p.Name, p.Denom, p.DurationUntilDecay, p.DurationOfDecay, p.DecayFunction, p.ActionWeights, p.ClaimRecords)
+}
+
+// MsgCreateCampaign returns the campaign of the proposal as created by the funder.
+func (p *CreateCampaignProposal) MsgCreateCampaign(funder string) *MsgCreateCampaign {
+	return NewMsgCreateCampaign(funder, p.Name, p.Denom, p.StartTime, p.DurationUntilDecay, p.DurationOfDecay, p.DecayFunction, p.ActionWeights, p.ClaimRecords)
+}
+
+// String implements the Stringer interface.
+func (p CreateCampaignProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Create Campaign Proposal:
+  Title:                %s
+  Description:          %s
+  Name:                 %s
+  Denom:                %s
+  Start Time:           %s
+  Duration Until Decay: %s
+  Duration Of Decay:    %s
+  Claim Records:
+`, p.Title, p.Description, p.Name, p.Denom, p.StartTime, p.DurationUntilDecay, p.DurationOfDecay))
+	for _, record := range p.ClaimRecords {
+		b.WriteString(fmt.Sprintf("    %s: %s\n", record.Address, record.InitialClaimableAmount))
+	}
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/public-awesome/stargaze/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimRecordsUpdateProposal_ValidateBasic(t *testing.T) {
+	addr := sample.AccAddress()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ustars", 100))
+	tests := []struct {
+		name     string
+		proposal *ClaimRecordsUpdateProposal
+		err      error
+	}{
+		{
+			name:     "empty title",
+			proposal: NewClaimRecordsUpdateProposal("", "description", []ClaimRecord{{Address: addr, InitialClaimableAmount: amount}}, nil),
+			err:      govtypes.ErrInvalidProposalContent,
+		}, {
+			name:     "nothing to update",
+			proposal: NewClaimRecordsUpdateProposal("title", "description", nil, nil),
+			err:      govtypes.ErrInvalidProposalContent,
+		}, {
+			name:     "invalid address",
+			proposal: NewClaimRecordsUpdateProposal("title", "description", []ClaimRecord{{Address: "invalid_address", InitialClaimableAmount: amount}}, nil),
+			err:      sdkerrors.ErrInvalidAddress,
+		}, {
+			name:     "empty amount",
+			proposal: NewClaimRecordsUpdateProposal("title", "description", []ClaimRecord{{Address: addr}}, nil),
+			err:      sdkerrors.ErrInvalidCoins,
+		}, {
+			name:     "invalid revoked address",
+			proposal: NewClaimRecordsUpdateProposal("title", "description", nil, []string{"invalid_address"}),
+			err:      sdkerrors.ErrInvalidAddress,
+		}, {
+			name:     "address updated and revoked",
+			proposal: NewClaimRecordsUpdateProposal("title", "description", []ClaimRecord{{Address: addr, InitialClaimableAmount: amount}}, []string{addr}),
+			err:      govtypes.ErrInvalidProposalContent,
+		}, {
+			name:     "valid",
+			proposal: NewClaimRecordsUpdateProposal("title", "description", []ClaimRecord{{Address: addr, InitialClaimableAmount: amount}}, []string{sample.AccAddress()}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateCampaignProposal_ValidateBasic(t *testing.T) {
+	addr := sample.AccAddress()
+	records := []ClaimRecord{{Address: addr, InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin("ustars", 100))}}
+	proposal := func(title string, claimRecords []ClaimRecord) *CreateCampaignProposal {
+		return NewCreateCampaignProposal(title, "description", "campaign", "ustars", time.Time{}, time.Hour, time.Hour, NewLinearDecay(), nil, claimRecords)
+	}
+	tests := []struct {
+		name     string
+		proposal *CreateCampaignProposal
+		err      error
+	}{
+		{
+			name:     "empty title",
+			proposal: proposal("", records),
+			err:      govtypes.ErrInvalidProposalContent,
+		}, {
+			name:     "no claim records",
+			proposal: proposal("title", nil),
+			err:      ErrInvalidCampaign,
+		}, {
+			name:     "other denom",
+			proposal: proposal("title", []ClaimRecord{{Address: addr, InitialClaimableAmount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))}}),
+			err:      ErrInvalidCampaign,
+		}, {
+			name:     "valid",
+			proposal: proposal("title", records),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -8,22 +8,28 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -469,764 +475,5008 @@ func (m *QueryTotalClaimableResponse) GetCoins() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryModuleAccountBalanceRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryModuleAccountBalanceRequest")
-	proto.RegisterType((*QueryModuleAccountBalanceResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryModuleAccountBalanceResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryClaimRecordRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimRecordRequest")
-	proto.RegisterType((*QueryClaimRecordResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimRecordResponse")
-	proto.RegisterType((*QueryClaimableForActionRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimableForActionRequest")
-	proto.RegisterType((*QueryClaimableForActionResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimableForActionResponse")
-	proto.RegisterType((*QueryTotalClaimableRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryTotalClaimableRequest")
-	proto.RegisterType((*QueryTotalClaimableResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryTotalClaimableResponse")
+type QueryAirdropStateRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("stargaze/claim/v1beta1/query.proto", fileDescriptor_b21e213c864ce5ea)
+func (m *QueryAirdropStateRequest) Reset()         { *m = QueryAirdropStateRequest{} }
+func (m *QueryAirdropStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAirdropStateRequest) ProtoMessage()    {}
+func (*QueryAirdropStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{10}
 }
-
-var fileDescriptor_b21e213c864ce5ea = []byte{
-	// 759 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x41, 0x6b, 0x13, 0x41,
-	0x18, 0x86, 0x33, 0xd5, 0x46, 0x9d, 0xd4, 0x82, 0xd3, 0x80, 0x71, 0x2b, 0x9b, 0x38, 0x8a, 0x44,
-	0x6c, 0x77, 0x9b, 0x16, 0xc4, 0x0a, 0x6a, 0x93, 0x6a, 0x0b, 0x05, 0x41, 0x83, 0x20, 0x78, 0x09,
-	0xb3, 0x9b, 0x31, 0x2e, 0xee, 0xee, 0xa4, 0x3b, 0x1b, 0xb5, 0x96, 0x5e, 0xbc, 0x8b, 0x05, 0x3d,
-	0xf8, 0x07, 0x44, 0xf0, 0xee, 0x3f, 0xf0, 0xd0, 0x63, 0xc1, 0x8b, 0xa7, 0x54, 0x5a, 0x4f, 0x1e,
-	0xfb, 0x0b, 0x64, 0x67, 0x26, 0xc9, 0xd6, 0x26, 0x35, 0x69, 0x41, 0x4f, 0x49, 0x76, 0xe7, 0x7b,
-	0xbf, 0xf7, 0xf9, 0x66, 0xe6, 0x25, 0x10, 0xf3, 0x90, 0x04, 0x35, 0xf2, 0x8a, 0x9a, 0xb6, 0x4b,
-	0x1c, 0xcf, 0x7c, 0x5e, 0xb0, 0x68, 0x48, 0x0a, 0xe6, 0x72, 0x83, 0x06, 0x2b, 0x46, 0x3d, 0x60,
-	0x21, 0x43, 0x97, 0xea, 0x0d, 0xcb, 0x75, 0x6c, 0xf2, 0x82, 0x72, 0xe6, 0x51, 0xa3, 0x55, 0x61,
-	0x88, 0x0a, 0x43, 0x55, 0x68, 0xe9, 0x1a, 0xab, 0x31, 0x51, 0x60, 0x46, 0xdf, 0x64, 0xad, 0x76,
-	0xbe, 0xc6, 0x58, 0xcd, 0xa5, 0x26, 0xa9, 0x3b, 0x26, 0xf1, 0x7d, 0x16, 0x92, 0xd0, 0x61, 0x3e,
-	0x57, 0x6f, 0x75, 0x9b, 0x71, 0x8f, 0x71, 0xd3, 0x22, 0x9c, 0xb6, 0x5b, 0xdb, 0xcc, 0xf1, 0xd5,
-	0xfb, 0x2b, 0x3d, 0xdc, 0x89, 0x5f, 0x95, 0x80, 0xda, 0x2c, 0xa8, 0xaa, 0xa5, 0x17, 0x7b, 0x2c,
-	0xad, 0x93, 0x80, 0x78, 0xaa, 0x1f, 0xc6, 0x30, 0xf7, 0x20, 0x02, 0xbb, 0xc7, 0xaa, 0x0d, 0x97,
-	0x16, 0x6d, 0x9b, 0x35, 0xfc, 0xb0, 0x44, 0x5c, 0xe2, 0xdb, 0xb4, 0x4c, 0x97, 0x1b, 0x94, 0x87,
-	0xf8, 0x0b, 0x80, 0x17, 0x0e, 0x58, 0xc4, 0xeb, 0xcc, 0xe7, 0x14, 0xbd, 0x05, 0x30, 0xed, 0x75,
-	0x59, 0x90, 0x01, 0xb9, 0x63, 0xf9, 0xd4, 0xf4, 0x39, 0x43, 0x92, 0x19, 0x11, 0x59, 0x6b, 0x44,
-	0xc6, 0x3c, 0x73, 0xfc, 0xd2, 0xdc, 0x46, 0x33, 0x9b, 0xd8, 0x6d, 0x66, 0x47, 0x56, 0x88, 0xe7,
-	0xde, 0xc0, 0x11, 0x2d, 0xc7, 0x9f, 0xb7, 0xb2, 0xf9, 0x9a, 0x13, 0x3e, 0x6d, 0x58, 0x86, 0xcd,
-	0x3c, 0x53, 0x8d, 0x45, 0x7e, 0x4c, 0xf2, 0xea, 0x33, 0x33, 0x5c, 0xa9, 0x53, 0x2e, 0x04, 0x78,
-	0xb9, 0x6b, 0x63, 0x9c, 0x86, 0x48, 0xd8, 0xbe, 0x2f, 0x80, 0x5b, 0x34, 0x04, 0x8e, 0xed, 0x79,
-	0xaa, 0xec, 0x2f, 0xc1, 0xa4, 0x1c, 0x4c, 0x06, 0xe4, 0x40, 0x3e, 0x35, 0x3d, 0x61, 0xf4, 0xb3,
-	0xc7, 0x86, 0x54, 0x29, 0x1d, 0x8f, 0x10, 0xca, 0x4a, 0x01, 0x2f, 0xc0, 0xb3, 0xa2, 0xc5, 0x7c,
-	0xb4, 0xb4, 0x2c, 0xf6, 0x44, 0x75, 0x47, 0x57, 0xe1, 0x09, 0x52, 0xad, 0x06, 0x94, 0xcb, 0x3e,
-	0xa7, 0x4a, 0x67, 0x76, 0x9b, 0xd9, 0xd3, 0x12, 0x9c, 0x53, 0xbf, 0x4a, 0x03, 0x5c, 0x6e, 0xad,
-	0xc0, 0x6f, 0x00, 0xcc, 0xec, 0x17, 0x52, 0x86, 0x97, 0xe1, 0x48, 0x7c, 0xd3, 0x95, 0xed, 0x42,
-	0x7f, 0xb6, 0x63, 0x82, 0xa5, 0x71, 0x35, 0xfe, 0x31, 0x35, 0xfe, 0x98, 0x28, 0x2e, 0xa7, 0xec,
-	0xce, 0x4a, 0xfc, 0x09, 0x40, 0xbd, 0xe3, 0x87, 0x58, 0x2e, 0x5d, 0x60, 0x41, 0xd1, 0x8e, 0xce,
-	0x6f, 0x8b, 0x6f, 0xe2, 0x4f, 0x3e, 0xb4, 0xdb, 0xcc, 0x8e, 0x4a, 0xe5, 0x16, 0x56, 0x1b, 0x10,
-	0x3d, 0x82, 0x49, 0x22, 0xca, 0x33, 0x43, 0x39, 0x90, 0x1f, 0xed, 0x77, 0xe8, 0xb2, 0x65, 0x7c,
-	0x74, 0x52, 0x05, 0x97, 0x95, 0x1c, 0x7e, 0x0f, 0x60, 0xb6, 0xa7, 0xd3, 0xf6, 0x00, 0x87, 0xc5,
-	0x51, 0xfb, 0x17, 0x07, 0x54, 0x76, 0xc2, 0x4b, 0x50, 0x13, 0xae, 0x1e, 0xb2, 0x90, 0xb8, 0x6d,
-	0x6b, 0x87, 0x9a, 0x1d, 0x5e, 0x07, 0x70, 0xbc, 0xab, 0xd8, 0x7f, 0xc3, 0x9b, 0xfe, 0x70, 0x12,
-	0x0e, 0x0b, 0x4b, 0x68, 0x0b, 0xc0, 0x74, 0xb7, 0xb4, 0x40, 0x0b, 0xfd, 0xed, 0xf0, 0xdf, 0x32,
-	0x49, 0x5b, 0x3c, 0xb2, 0x8e, 0x1c, 0x13, 0xbe, 0xf6, 0xfa, 0xdb, 0xcf, 0x77, 0x43, 0x53, 0xc8,
-	0x30, 0x7b, 0xc4, 0xa5, 0x8c, 0x96, 0x0a, 0x91, 0xe5, 0x15, 0x4b, 0x81, 0x7c, 0x04, 0x30, 0x29,
-	0x2f, 0x3f, 0xba, 0x3e, 0x80, 0x97, 0x3d, 0x59, 0xa4, 0xcd, 0x1e, 0xa2, 0x52, 0xf9, 0xbe, 0x2c,
-	0x7c, 0xe7, 0x90, 0x6e, 0x1e, 0x18, 0xf3, 0xe8, 0x2b, 0x80, 0xa9, 0xd8, 0x6d, 0x47, 0x37, 0x07,
-	0x68, 0xb9, 0x3f, 0xbf, 0xb4, 0x5b, 0x87, 0x2d, 0xef, 0x77, 0xdc, 0xf1, 0xf8, 0x31, 0x57, 0xd5,
-	0x61, 0x5f, 0x43, 0xbf, 0x00, 0x44, 0xfb, 0xef, 0x32, 0xba, 0x33, 0xa8, 0x9d, 0x6e, 0xa1, 0xa5,
-	0xdd, 0x3d, 0xa2, 0x8a, 0x62, 0x5b, 0x14, 0x6c, 0x45, 0x74, 0xfb, 0x40, 0xb6, 0xa8, 0xb6, 0xf2,
-	0x84, 0x05, 0x15, 0x19, 0x55, 0x1d, 0x46, 0x73, 0x55, 0x3e, 0x59, 0x43, 0x9b, 0x00, 0x8e, 0xee,
-	0xbd, 0xd5, 0x68, 0x6e, 0x00, 0x8b, 0x5d, 0xd3, 0x45, 0x2b, 0x1e, 0x41, 0x41, 0x01, 0xce, 0x0a,
-	0xc0, 0x19, 0x54, 0xe8, 0x05, 0x18, 0x46, 0x75, 0x95, 0x36, 0x66, 0x87, 0xad, 0xb4, 0xb4, 0xb1,
-	0xad, 0x83, 0xcd, 0x6d, 0x1d, 0xfc, 0xd8, 0xd6, 0xc1, 0xfa, 0x8e, 0x9e, 0xd8, 0xdc, 0xd1, 0x13,
-	0xdf, 0x77, 0xf4, 0xc4, 0xe3, 0xa9, 0x58, 0xca, 0x48, 0x87, 0x93, 0xca, 0x62, 0xa7, 0xcb, 0x4b,
-	0xd5, 0x47, 0x64, 0x8e, 0x95, 0x14, 0x7f, 0x5d, 0x66, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x39,
-	0xaa, 0xef, 0x8e, 0xaa, 0x09, 0x00, 0x00,
+func (m *QueryAirdropStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAirdropStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAirdropStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAirdropStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAirdropStateRequest.Merge(m, src)
+}
+func (m *QueryAirdropStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAirdropStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAirdropStateRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAirdropStateRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// this line is used by starport scaffolding # 2
-	ModuleAccountBalance(ctx context.Context, in *QueryModuleAccountBalanceRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalanceResponse, error)
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	ClaimRecord(ctx context.Context, in *QueryClaimRecordRequest, opts ...grpc.CallOption) (*QueryClaimRecordResponse, error)
-	ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error)
-	TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error)
+type QueryAirdropStateResponse struct {
+	AirdropState AirdropState `protobuf:"bytes,1,opt,name=airdrop_state,json=airdropState,proto3" json:"airdrop_state" yaml:"airdrop_state"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAirdropStateResponse) Reset()         { *m = QueryAirdropStateResponse{} }
+func (m *QueryAirdropStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAirdropStateResponse) ProtoMessage()    {}
+func (*QueryAirdropStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{11}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAirdropStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ModuleAccountBalance(ctx context.Context, in *QueryModuleAccountBalanceRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalanceResponse, error) {
-	out := new(QueryModuleAccountBalanceResponse)
-	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/ModuleAccountBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAirdropStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAirdropStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAirdropStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAirdropStateResponse.Merge(m, src)
+}
+func (m *QueryAirdropStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAirdropStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAirdropStateResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAirdropStateResponse proto.InternalMessageInfo
+
+func (m *QueryAirdropStateResponse) GetAirdropState() AirdropState {
+	if m != nil {
+		return m.AirdropState
 	}
-	return out, nil
+	return AirdropState{}
 }
 
-func (c *queryClient) ClaimRecord(ctx context.Context, in *QueryClaimRecordRequest, opts ...grpc.CallOption) (*QueryClaimRecordResponse, error) {
-	out := new(QueryClaimRecordResponse)
-	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/ClaimRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryClaimableScheduleRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// time between two projections, starting at the current block time
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval" yaml:"interval"`
+	// number of projections
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty" yaml:"count"`
+}
+
+func (m *QueryClaimableScheduleRequest) Reset()         { *m = QueryClaimableScheduleRequest{} }
+func (m *QueryClaimableScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableScheduleRequest) ProtoMessage()    {}
+func (*QueryClaimableScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{12}
+}
+func (m *QueryClaimableScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryClaimableScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableScheduleRequest.Merge(m, src)
+}
+func (m *QueryClaimableScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableScheduleRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error) {
-	out := new(QueryClaimableForActionResponse)
-	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/ClaimableForAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimableScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableScheduleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error) {
-	out := new(QueryTotalClaimableResponse)
-	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/TotalClaimable", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryClaimableScheduleRequest) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// this line is used by starport scaffolding # 2
-	ModuleAccountBalance(context.Context, *QueryModuleAccountBalanceRequest) (*QueryModuleAccountBalanceResponse, error)
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	ClaimRecord(context.Context, *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error)
-	ClaimableForAction(context.Context, *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error)
-	TotalClaimable(context.Context, *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error)
+func (m *QueryClaimableScheduleRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// ClaimableAtTime is the projected total claimable amount at a point in time
+type ClaimableAtTime struct {
+	Time  time.Time                                `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins" yaml:"coins"`
 }
 
-func (*UnimplementedQueryServer) ModuleAccountBalance(ctx context.Context, req *QueryModuleAccountBalanceRequest) (*QueryModuleAccountBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountBalance not implemented")
+func (m *ClaimableAtTime) Reset()         { *m = ClaimableAtTime{} }
+func (m *ClaimableAtTime) String() string { return proto.CompactTextString(m) }
+func (*ClaimableAtTime) ProtoMessage()    {}
+func (*ClaimableAtTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{13}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *ClaimableAtTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ClaimRecord(ctx context.Context, req *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimRecord not implemented")
+func (m *ClaimableAtTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableAtTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ClaimableForAction(ctx context.Context, req *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableForAction not implemented")
+func (m *ClaimableAtTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableAtTime.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalClaimable(ctx context.Context, req *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalClaimable not implemented")
+func (m *ClaimableAtTime) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *ClaimableAtTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableAtTime.DiscardUnknown(m)
 }
 
-func _Query_ModuleAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleAccountBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModuleAccountBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/ModuleAccountBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModuleAccountBalance(ctx, req.(*QueryModuleAccountBalanceRequest))
+var xxx_messageInfo_ClaimableAtTime proto.InternalMessageInfo
+
+func (m *ClaimableAtTime) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *ClaimableAtTime) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/ClaimRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimRecord(ctx, req.(*QueryClaimRecordRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryClaimableScheduleResponse struct {
+	Schedule []ClaimableAtTime `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule" yaml:"schedule"`
 }
 
-func _Query_ClaimableForAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimableForActionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableForAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/ClaimableForAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableForAction(ctx, req.(*QueryClaimableForActionRequest))
+func (m *QueryClaimableScheduleResponse) Reset()         { *m = QueryClaimableScheduleResponse{} }
+func (m *QueryClaimableScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableScheduleResponse) ProtoMessage()    {}
+func (*QueryClaimableScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{14}
+}
+func (m *QueryClaimableScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryClaimableScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableScheduleResponse.Merge(m, src)
+}
+func (m *QueryClaimableScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableScheduleResponse.DiscardUnknown(m)
 }
 
-func _Query_TotalClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalClaimableRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalClaimable(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/TotalClaimable",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalClaimable(ctx, req.(*QueryTotalClaimableRequest))
+var xxx_messageInfo_QueryClaimableScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableScheduleResponse) GetSchedule() []ClaimableAtTime {
+	if m != nil {
+		return m.Schedule
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "publicawesome.stargaze.claim.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ModuleAccountBalance",
-			Handler:    _Query_ModuleAccountBalance_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ClaimRecord",
-			Handler:    _Query_ClaimRecord_Handler,
-		},
-		{
-			MethodName: "ClaimableForAction",
-			Handler:    _Query_ClaimableForAction_Handler,
-		},
-		{
-			MethodName: "TotalClaimable",
-			Handler:    _Query_TotalClaimable_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stargaze/claim/v1beta1/query.proto",
+type QueryCampaignsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryModuleAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCampaignsRequest) Reset()         { *m = QueryCampaignsRequest{} }
+func (m *QueryCampaignsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignsRequest) ProtoMessage()    {}
+func (*QueryCampaignsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{15}
+}
+func (m *QueryCampaignsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryModuleAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCampaignsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignsRequest.Merge(m, src)
 }
-
-func (m *QueryModuleAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCampaignsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryModuleAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCampaignsRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryModuleAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCampaignsResponse struct {
+	Campaigns  []Campaign          `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns" yaml:"campaigns"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryModuleAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ModuleAccountBalance) > 0 {
-		for iNdEx := len(m.ModuleAccountBalance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ModuleAccountBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCampaignsResponse) Reset()         { *m = QueryCampaignsResponse{} }
+func (m *QueryCampaignsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignsResponse) ProtoMessage()    {}
+func (*QueryCampaignsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{16}
+}
+func (m *QueryCampaignsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCampaignsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignsResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCampaignsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryCampaignsResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignsResponse) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCampaignsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCampaignRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCampaignRequest) Reset()         { *m = QueryCampaignRequest{} }
+func (m *QueryCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignRequest) ProtoMessage()    {}
+func (*QueryCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{17}
+}
+func (m *QueryCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignRequest.Merge(m, src)
+}
+func (m *QueryCampaignRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClaimRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCampaignRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryClaimRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCampaignResponse struct {
+	Campaign Campaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign" yaml:"campaign"`
 }
 
-func (m *QueryClaimRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCampaignResponse) Reset()         { *m = QueryCampaignResponse{} }
+func (m *QueryCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignResponse) ProtoMessage()    {}
+func (*QueryCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{18}
+}
+func (m *QueryCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignResponse.Merge(m, src)
+}
+func (m *QueryCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClaimRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCampaignResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignResponse) GetCampaign() Campaign {
+	if m != nil {
+		return m.Campaign
 	}
-	return dAtA[:n], nil
+	return Campaign{}
 }
 
-func (m *QueryClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCampaignClaimRecordRequest struct {
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty" yaml:"campaign_id"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 }
 
-func (m *QueryClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClaimRecord.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCampaignClaimRecordRequest) Reset()         { *m = QueryCampaignClaimRecordRequest{} }
+func (m *QueryCampaignClaimRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignClaimRecordRequest) ProtoMessage()    {}
+func (*QueryCampaignClaimRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{19}
+}
+func (m *QueryCampaignClaimRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignClaimRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignClaimRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCampaignClaimRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignClaimRecordRequest.Merge(m, src)
+}
+func (m *QueryCampaignClaimRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignClaimRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignClaimRecordRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClaimableForActionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCampaignClaimRecordRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignClaimRecordRequest) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryClaimableForActionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCampaignClaimRecordRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryClaimableForActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Action != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Action))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+type QueryCampaignClaimRecordResponse struct {
+	ClaimRecord ClaimRecord `protobuf:"bytes,1,opt,name=claim_record,json=claimRecord,proto3" json:"claim_record" yaml:"claim_record"`
+}
+
+func (m *QueryCampaignClaimRecordResponse) Reset()         { *m = QueryCampaignClaimRecordResponse{} }
+func (m *QueryCampaignClaimRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignClaimRecordResponse) ProtoMessage()    {}
+func (*QueryCampaignClaimRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{20}
+}
+func (m *QueryCampaignClaimRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignClaimRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignClaimRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCampaignClaimRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignClaimRecordResponse.Merge(m, src)
+}
+func (m *QueryCampaignClaimRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignClaimRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignClaimRecordResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClaimableForActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCampaignClaimRecordResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignClaimRecordResponse) GetClaimRecord() ClaimRecord {
+	if m != nil {
+		return m.ClaimRecord
 	}
-	return dAtA[:n], nil
+	return ClaimRecord{}
 }
 
-func (m *QueryClaimableForActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryClaimRecordsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryClaimableForActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryClaimRecordsRequest) Reset()         { *m = QueryClaimRecordsRequest{} }
+func (m *QueryClaimRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimRecordsRequest) ProtoMessage()    {}
+func (*QueryClaimRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{21}
+}
+func (m *QueryClaimRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClaimRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimRecordsRequest.Merge(m, src)
+}
+func (m *QueryClaimRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalClaimableRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryClaimRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTotalClaimableRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryClaimRecordsResponse struct {
+	ClaimRecords []ClaimRecord       `protobuf:"bytes,1,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records" yaml:"claim_records"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalClaimableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryClaimRecordsResponse) Reset()         { *m = QueryClaimRecordsResponse{} }
+func (m *QueryClaimRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimRecordsResponse) ProtoMessage()    {}
+func (*QueryClaimRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{22}
+}
+func (m *QueryClaimRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClaimRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimRecordsResponse.Merge(m, src)
+}
+func (m *QueryClaimRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalClaimableResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimRecordsResponse) GetClaimRecords() []ClaimRecord {
+	if m != nil {
+		return m.ClaimRecords
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTotalClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClaimRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryTotalClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type QueryAirdropStatsRequest struct {
+}
+
+func (m *QueryAirdropStatsRequest) Reset()         { *m = QueryAirdropStatsRequest{} }
+func (m *QueryAirdropStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAirdropStatsRequest) ProtoMessage()    {}
+func (*QueryAirdropStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{23}
+}
+func (m *QueryAirdropStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAirdropStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAirdropStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAirdropStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAirdropStatsRequest.Merge(m, src)
+}
+func (m *QueryAirdropStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAirdropStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAirdropStatsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryAirdropStatsRequest proto.InternalMessageInfo
+
+type QueryAirdropStatsResponse struct {
+	// sum of the initial claimable amounts of all claim records
+	TotalAllocated github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_allocated,json=totalAllocated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_allocated" yaml:"total_allocated"`
+	// amount claimed for each action
+	Claimed []ActionClaimed `protobuf:"bytes,2,rep,name=claimed,proto3" json:"claimed" yaml:"claimed"`
+	// number of addresses that completed every rewarded action
+	FullyClaimedAddresses uint64 `protobuf:"varint,3,opt,name=fully_claimed_addresses,json=fullyClaimedAddresses,proto3" json:"fully_claimed_addresses,omitempty" yaml:"fully_claimed_addresses"`
+	// unclaimed airdrop balance of the module account
+	RemainingBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=remaining_balance,json=remainingBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_balance" yaml:"remaining_balance"`
+}
+
+func (m *QueryAirdropStatsResponse) Reset()         { *m = QueryAirdropStatsResponse{} }
+func (m *QueryAirdropStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAirdropStatsResponse) ProtoMessage()    {}
+func (*QueryAirdropStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{24}
+}
+func (m *QueryAirdropStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAirdropStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAirdropStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryModuleAccountBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAirdropStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAirdropStatsResponse.Merge(m, src)
+}
+func (m *QueryAirdropStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAirdropStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAirdropStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAirdropStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAirdropStatsResponse) GetTotalAllocated() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalAllocated
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryModuleAccountBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAirdropStatsResponse) GetClaimed() []ActionClaimed {
+	if m != nil {
+		return m.Claimed
 	}
-	var l int
-	_ = l
-	if len(m.ModuleAccountBalance) > 0 {
-		for _, e := range m.ModuleAccountBalance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryAirdropStatsResponse) GetFullyClaimedAddresses() uint64 {
+	if m != nil {
+		return m.FullyClaimedAddresses
+	}
+	return 0
+}
+
+func (m *QueryAirdropStatsResponse) GetRemainingBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingBalance
+	}
+	return nil
+}
+
+type QueryClaimHistoryRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *QueryClaimHistoryRequest) Reset()         { *m = QueryClaimHistoryRequest{} }
+func (m *QueryClaimHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimHistoryRequest) ProtoMessage()    {}
+func (*QueryClaimHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{25}
+}
+func (m *QueryClaimHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimHistoryRequest.Merge(m, src)
+}
+func (m *QueryClaimHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryClaimHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ClaimHistoryEntry is a claim of the airdrop or of a campaign
+type ClaimHistoryEntry struct {
+	// campaign the claim was made from, 0 for the airdrop
+	CampaignId uint64      `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty" yaml:"campaign_id"`
+	Claim      ActionClaim `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim" yaml:"claim"`
+}
+
+func (m *ClaimHistoryEntry) Reset()         { *m = ClaimHistoryEntry{} }
+func (m *ClaimHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ClaimHistoryEntry) ProtoMessage()    {}
+func (*ClaimHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{26}
+}
+func (m *ClaimHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimHistoryEntry.Merge(m, src)
+}
+func (m *ClaimHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimHistoryEntry proto.InternalMessageInfo
+
+func (m *ClaimHistoryEntry) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *ClaimHistoryEntry) GetClaim() ActionClaim {
+	if m != nil {
+		return m.Claim
+	}
+	return ActionClaim{}
+}
+
+type QueryClaimHistoryResponse struct {
+	History []ClaimHistoryEntry `protobuf:"bytes,1,rep,name=history,proto3" json:"history" yaml:"history"`
+	// total amount received from the airdrop and all campaigns
+	TotalClaimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_claimed,json=totalClaimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_claimed" yaml:"total_claimed"`
+}
+
+func (m *QueryClaimHistoryResponse) Reset()         { *m = QueryClaimHistoryResponse{} }
+func (m *QueryClaimHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimHistoryResponse) ProtoMessage()    {}
+func (*QueryClaimHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{27}
+}
+func (m *QueryClaimHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimHistoryResponse.Merge(m, src)
+}
+func (m *QueryClaimHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryClaimHistoryResponse) GetHistory() []ClaimHistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryClaimHistoryResponse) GetTotalClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalClaimed
+	}
+	return nil
+}
+
+type QueryVestingEscrowRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *QueryVestingEscrowRequest) Reset()         { *m = QueryVestingEscrowRequest{} }
+func (m *QueryVestingEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingEscrowRequest) ProtoMessage()    {}
+func (*QueryVestingEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{28}
+}
+func (m *QueryVestingEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingEscrowRequest.Merge(m, src)
+}
+func (m *QueryVestingEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryVestingEscrowRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryVestingEscrowResponse struct {
+	VestingEscrow VestingEscrow `protobuf:"bytes,1,opt,name=vesting_escrow,json=vestingEscrow,proto3" json:"vesting_escrow" yaml:"vesting_escrow"`
+	// amount that can be released at the current block time
+	Releasable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=releasable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"releasable" yaml:"releasable"`
+}
+
+func (m *QueryVestingEscrowResponse) Reset()         { *m = QueryVestingEscrowResponse{} }
+func (m *QueryVestingEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingEscrowResponse) ProtoMessage()    {}
+func (*QueryVestingEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21e213c864ce5ea, []int{29}
+}
+func (m *QueryVestingEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingEscrowResponse.Merge(m, src)
+}
+func (m *QueryVestingEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryVestingEscrowResponse) GetVestingEscrow() VestingEscrow {
+	if m != nil {
+		return m.VestingEscrow
+	}
+	return VestingEscrow{}
+}
+
+func (m *QueryVestingEscrowResponse) GetReleasable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Releasable
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryModuleAccountBalanceRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryModuleAccountBalanceRequest")
+	proto.RegisterType((*QueryModuleAccountBalanceResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryModuleAccountBalanceResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryClaimRecordRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimRecordRequest")
+	proto.RegisterType((*QueryClaimRecordResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimRecordResponse")
+	proto.RegisterType((*QueryClaimableForActionRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimableForActionRequest")
+	proto.RegisterType((*QueryClaimableForActionResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimableForActionResponse")
+	proto.RegisterType((*QueryTotalClaimableRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryTotalClaimableRequest")
+	proto.RegisterType((*QueryTotalClaimableResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryTotalClaimableResponse")
+	proto.RegisterType((*QueryAirdropStateRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryAirdropStateRequest")
+	proto.RegisterType((*QueryAirdropStateResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryAirdropStateResponse")
+	proto.RegisterType((*QueryClaimableScheduleRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimableScheduleRequest")
+	proto.RegisterType((*ClaimableAtTime)(nil), "publicawesome.stargaze.claim.v1beta1.ClaimableAtTime")
+	proto.RegisterType((*QueryClaimableScheduleResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimableScheduleResponse")
+	proto.RegisterType((*QueryCampaignsRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryCampaignsRequest")
+	proto.RegisterType((*QueryCampaignsResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryCampaignsResponse")
+	proto.RegisterType((*QueryCampaignRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryCampaignRequest")
+	proto.RegisterType((*QueryCampaignResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryCampaignResponse")
+	proto.RegisterType((*QueryCampaignClaimRecordRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryCampaignClaimRecordRequest")
+	proto.RegisterType((*QueryCampaignClaimRecordResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryCampaignClaimRecordResponse")
+	proto.RegisterType((*QueryClaimRecordsRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimRecordsRequest")
+	proto.RegisterType((*QueryClaimRecordsResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimRecordsResponse")
+	proto.RegisterType((*QueryAirdropStatsRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryAirdropStatsRequest")
+	proto.RegisterType((*QueryAirdropStatsResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryAirdropStatsResponse")
+	proto.RegisterType((*QueryClaimHistoryRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimHistoryRequest")
+	proto.RegisterType((*ClaimHistoryEntry)(nil), "publicawesome.stargaze.claim.v1beta1.ClaimHistoryEntry")
+	proto.RegisterType((*QueryClaimHistoryResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryClaimHistoryResponse")
+	proto.RegisterType((*QueryVestingEscrowRequest)(nil), "publicawesome.stargaze.claim.v1beta1.QueryVestingEscrowRequest")
+	proto.RegisterType((*QueryVestingEscrowResponse)(nil), "publicawesome.stargaze.claim.v1beta1.QueryVestingEscrowResponse")
+}
+
+func init() {
+	proto.RegisterFile("stargaze/claim/v1beta1/query.proto", fileDescriptor_b21e213c864ce5ea)
+}
+
+var fileDescriptor_b21e213c864ce5ea = []byte{
+	// 1842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xcd, 0x3a, 0x5f, 0x65, 0x8f, 0x93, 0x54, 0x1c, 0xc7, 0xdb, 0xbb, 0x99, 0xf1, 0xd6,
+	0xb2, 0x59, 0xef, 0x47, 0xa6, 0x63, 0x87, 0xdd, 0xec, 0x17, 0x24, 0xd3, 0x8e, 0x3f, 0xd6, 0x02,
+	0x04, 0xbd, 0x11, 0x48, 0x2b, 0xa1, 0x51, 0x4d, 0x77, 0x79, 0xd2, 0x30, 0x33, 0x3d, 0xe9, 0xee,
+	0xf1, 0x62, 0x82, 0x25, 0xc4, 0x01, 0xed, 0x81, 0x8f, 0x00, 0x8b, 0x84, 0xb8, 0x23, 0x24, 0x38,
+	0x21, 0xb4, 0x57, 0x4e, 0x1c, 0x82, 0x04, 0x52, 0x24, 0x2e, 0x08, 0x21, 0x07, 0x25, 0x9c, 0x38,
+	0x5a, 0xfc, 0x01, 0xa8, 0xab, 0x5e, 0xf5, 0x54, 0xcf, 0x87, 0x67, 0xba, 0xbd, 0xda, 0x3d, 0x39,
+	0xd3, 0xf5, 0xde, 0xaf, 0xde, 0xef, 0xd5, 0xab, 0xf7, 0x5e, 0xbd, 0x60, 0x1a, 0x46, 0x2c, 0x68,
+	0xb0, 0xef, 0x72, 0xd3, 0x69, 0x32, 0xaf, 0x65, 0xee, 0x2c, 0xd7, 0x79, 0xc4, 0x96, 0xcd, 0xbb,
+	0x5d, 0x1e, 0xec, 0x56, 0x3a, 0x81, 0x1f, 0xf9, 0xe4, 0x73, 0x9d, 0x6e, 0xbd, 0xe9, 0x39, 0xec,
+	0x03, 0x1e, 0xfa, 0x2d, 0x5e, 0x51, 0x1a, 0x15, 0xa1, 0x51, 0x01, 0x0d, 0x63, 0xae, 0xe1, 0x37,
+	0x7c, 0xa1, 0x60, 0xc6, 0xff, 0x92, 0xba, 0xc6, 0xb3, 0x0d, 0xdf, 0x6f, 0x34, 0xb9, 0xc9, 0x3a,
+	0x9e, 0xc9, 0xda, 0x6d, 0x3f, 0x62, 0x91, 0xe7, 0xb7, 0x43, 0x58, 0x2d, 0xc1, 0xaa, 0xf8, 0x55,
+	0xef, 0x6e, 0x9b, 0x6e, 0x37, 0x10, 0x02, 0xb0, 0x5e, 0xee, 0x5f, 0x8f, 0xbc, 0x16, 0x0f, 0x23,
+	0xd6, 0xea, 0x28, 0x00, 0xc7, 0x0f, 0x5b, 0x7e, 0x68, 0xd6, 0x59, 0xc8, 0x13, 0xdb, 0x1d, 0xdf,
+	0x53, 0x00, 0x2f, 0xeb, 0xeb, 0x82, 0x53, 0x22, 0xd5, 0x61, 0x0d, 0xaf, 0xad, 0x6f, 0xf6, 0xf2,
+	0x08, 0x57, 0x30, 0x2f, 0x70, 0x03, 0xbf, 0x53, 0x0b, 0x23, 0x16, 0x71, 0x90, 0x7d, 0x61, 0x84,
+	0xac, 0xc3, 0x5a, 0x1d, 0xe6, 0x35, 0x14, 0xe4, 0x4b, 0xa3, 0xc4, 0xe2, 0x5f, 0xb5, 0x80, 0x3b,
+	0x7e, 0xe0, 0x82, 0xe8, 0xf3, 0x23, 0x44, 0x3b, 0x2c, 0x60, 0x2d, 0xe5, 0xaf, 0x57, 0x46, 0x08,
+	0xed, 0xf0, 0x30, 0xf2, 0xda, 0x8d, 0x1a, 0x0f, 0x9d, 0xc0, 0xff, 0x40, 0x0a, 0x53, 0x8a, 0x17,
+	0xbf, 0x16, 0x33, 0xfe, 0xb2, 0xef, 0x76, 0x9b, 0xbc, 0xea, 0x38, 0x7e, 0xb7, 0x1d, 0x59, 0xac,
+	0xc9, 0xda, 0x0e, 0xb7, 0xf9, 0xdd, 0x2e, 0x0f, 0x23, 0xfa, 0x31, 0xc2, 0xcf, 0x1d, 0x22, 0x14,
+	0x76, 0xfc, 0x76, 0xc8, 0xc9, 0x4f, 0x11, 0x9e, 0x6b, 0x0d, 0x11, 0x58, 0x40, 0x8b, 0x4f, 0x2d,
+	0x4d, 0xaf, 0x3c, 0x5d, 0x91, 0x5e, 0xae, 0xc4, 0x5e, 0x56, 0xf1, 0x50, 0x59, 0xf5, 0xbd, 0xb6,
+	0x75, 0xf3, 0xc1, 0x7e, 0xf9, 0xd8, 0xc1, 0x7e, 0x79, 0x66, 0x97, 0xb5, 0x9a, 0x6f, 0xd1, 0xf8,
+	0x64, 0x42, 0xfa, 0xbb, 0x47, 0xe5, 0xa5, 0x86, 0x17, 0xdd, 0xe9, 0xd6, 0x2b, 0x8e, 0xdf, 0x32,
+	0xe1, 0x88, 0xe4, 0x9f, 0x2b, 0xa1, 0xfb, 0x6d, 0x33, 0xda, 0xed, 0xf0, 0x50, 0x00, 0x84, 0xf6,
+	0xd0, 0x8d, 0xe9, 0x1c, 0x26, 0xc2, 0xec, 0xaf, 0x0a, 0xef, 0x28, 0x36, 0x0c, 0x9f, 0x4f, 0x7d,
+	0x05, 0xf3, 0xb7, 0xf0, 0x09, 0xe9, 0xc5, 0x05, 0xb4, 0x88, 0x96, 0xa6, 0x57, 0x5e, 0xad, 0x4c,
+	0x12, 0xd0, 0x15, 0x89, 0x62, 0x4d, 0xc5, 0x14, 0x6c, 0x40, 0xa0, 0xeb, 0xf8, 0xa2, 0xd8, 0x62,
+	0x35, 0x16, 0xb5, 0xc5, 0x01, 0xc2, 0xee, 0xe4, 0x15, 0x7c, 0x92, 0xb9, 0x6e, 0xc0, 0x43, 0xb9,
+	0xcf, 0x69, 0xeb, 0xdc, 0xc1, 0x7e, 0xb9, 0x28, 0x89, 0x87, 0xbc, 0xed, 0xf2, 0x80, 0xda, 0x4a,
+	0x82, 0xfe, 0x18, 0xe1, 0x85, 0x41, 0x20, 0x30, 0xf8, 0x2e, 0x9e, 0xd1, 0x23, 0x04, 0xcc, 0x5e,
+	0x9e, 0xcc, 0x6c, 0x0d, 0xd0, 0x7a, 0x06, 0xdc, 0x7f, 0x1e, 0xdc, 0xaf, 0x81, 0x52, 0x7b, 0xda,
+	0xe9, 0x49, 0xd2, 0xdf, 0x22, 0x5c, 0xea, 0xd9, 0xc3, 0xea, 0x4d, 0xbe, 0xee, 0x07, 0x55, 0x27,
+	0xbe, 0x1e, 0x8a, 0xdf, 0xab, 0xfd, 0xfc, 0xc8, 0xc1, 0x7e, 0x79, 0x56, 0x22, 0x2b, 0x5a, 0x09,
+	0x41, 0xf2, 0x0d, 0x7c, 0x82, 0x09, 0xf5, 0x85, 0xc2, 0x22, 0x5a, 0x9a, 0x9d, 0xd4, 0xe9, 0x72,
+	0x4b, 0xdd, 0x75, 0x12, 0x85, 0xda, 0x00, 0x47, 0x3f, 0x42, 0xb8, 0x3c, 0xd2, 0xd2, 0xc4, 0x81,
+	0xc7, 0x45, 0xa8, 0x7d, 0x1a, 0x01, 0x2a, 0x77, 0xa2, 0x5b, 0xd8, 0x10, 0x56, 0xdd, 0xf6, 0x23,
+	0xd6, 0x4c, 0x4c, 0xcb, 0xe5, 0x3b, 0x7a, 0x1f, 0xe1, 0x67, 0x86, 0x82, 0x7d, 0x76, 0xf4, 0x0c,
+	0x08, 0xd7, 0xaa, 0x4c, 0x86, 0xef, 0xc5, 0xb9, 0x50, 0x5d, 0xbb, 0x9f, 0x23, 0xfc, 0xf4, 0x90,
+	0x45, 0x30, 0xb6, 0x8b, 0x8b, 0xa9, 0x0c, 0x0a, 0xd1, 0xbc, 0x32, 0x61, 0x3c, 0x68, 0x90, 0xd6,
+	0xb3, 0xc0, 0x66, 0x0e, 0x1c, 0xa7, 0xc3, 0x52, 0x7b, 0x86, 0x69, 0xb2, 0xf4, 0x2f, 0x08, 0x5f,
+	0x4a, 0x87, 0xc9, 0x7b, 0xce, 0x1d, 0x1e, 0xa7, 0x92, 0x7c, 0xf1, 0x6c, 0xe3, 0x53, 0x5e, 0x3b,
+	0xe2, 0xc1, 0x0e, 0x6b, 0x8a, 0x88, 0x8e, 0xdd, 0x2e, 0xab, 0x53, 0x45, 0x55, 0xa7, 0xca, 0x2d,
+	0xa8, 0x5e, 0xc9, 0xbd, 0x3b, 0x23, 0xd1, 0x94, 0x22, 0xfd, 0xd5, 0xa3, 0x32, 0xb2, 0x13, 0x1c,
+	0x72, 0x39, 0x3e, 0xc7, 0x6e, 0x3b, 0x5a, 0x78, 0x6a, 0x11, 0x2d, 0x15, 0xad, 0xb3, 0xfa, 0x41,
+	0x75, 0xdb, 0x11, 0xb5, 0xe5, 0x32, 0xfd, 0x1b, 0xc2, 0x67, 0x12, 0x1a, 0xd5, 0xe8, 0xb6, 0xd7,
+	0xe2, 0x64, 0x03, 0x4f, 0xc5, 0xc5, 0x10, 0xbc, 0x69, 0x0c, 0xd8, 0x72, 0x5b, 0x55, 0x4a, 0xeb,
+	0x22, 0x18, 0x33, 0x2d, 0xa1, 0x63, 0x2d, 0x7a, 0x3f, 0x36, 0x44, 0x00, 0xf4, 0x82, 0xa9, 0xf0,
+	0xa9, 0x05, 0xd3, 0x8f, 0x06, 0x92, 0x4d, 0xef, 0x6c, 0x20, 0x6a, 0xbe, 0x85, 0x4f, 0x85, 0xf0,
+	0x0d, 0xa2, 0xfc, 0xb5, 0x0c, 0xe9, 0xaf, 0xe7, 0x27, 0xeb, 0x62, 0xfa, 0x28, 0x14, 0x28, 0xb5,
+	0x13, 0x7c, 0x5a, 0xc3, 0x17, 0xa4, 0x35, 0x50, 0xbc, 0x55, 0x3d, 0x21, 0xeb, 0x18, 0xf7, 0xba,
+	0x04, 0xf0, 0xf4, 0xe5, 0x94, 0x7f, 0x64, 0x9b, 0xd4, 0xab, 0x18, 0x0d, 0x15, 0x5d, 0xb6, 0xa6,
+	0x19, 0xc7, 0xe2, 0x7c, 0xff, 0x0e, 0xc0, 0x73, 0x1b, 0x9f, 0x56, 0x3d, 0x83, 0xba, 0xce, 0x95,
+	0x09, 0x89, 0x82, 0x9a, 0xb5, 0x00, 0x0c, 0xcf, 0xc2, 0xb1, 0x28, 0x38, 0x6a, 0xf7, 0xa0, 0xc9,
+	0x46, 0x8a, 0x8a, 0x0c, 0xe0, 0x17, 0xc7, 0x52, 0x91, 0x46, 0xa6, 0xb8, 0xbc, 0x86, 0xe7, 0x52,
+	0x54, 0x94, 0xaf, 0x2e, 0xe1, 0x82, 0x27, 0x2b, 0xd5, 0x94, 0x55, 0x3c, 0xd8, 0x2f, 0x9f, 0x86,
+	0xd0, 0x77, 0xa9, 0x5d, 0xf0, 0x5c, 0xfa, 0xbd, 0x3e, 0x1f, 0x27, 0x0e, 0x70, 0xf0, 0x29, 0x65,
+	0x25, 0x78, 0x38, 0x2b, 0xff, 0xbe, 0x13, 0x56, 0x68, 0xd4, 0x4e, 0x80, 0xe9, 0x87, 0x49, 0xcd,
+	0x80, 0x2f, 0x43, 0xca, 0xf7, 0x75, 0x3c, 0xad, 0xe4, 0x6b, 0x09, 0x93, 0xf9, 0x83, 0xfd, 0x32,
+	0x49, 0xe3, 0xd6, 0x62, 0x4a, 0x58, 0xfd, 0x7a, 0xd7, 0xd5, 0xf3, 0x48, 0x61, 0x7c, 0x6e, 0xff,
+	0x25, 0xc2, 0x8b, 0xa3, 0x4d, 0xf9, 0xec, 0x1a, 0x80, 0xfa, 0x60, 0x3f, 0xf2, 0x89, 0xdf, 0x83,
+	0x7f, 0xaa, 0x42, 0x91, 0xde, 0x04, 0x48, 0x47, 0xb8, 0xa8, 0xdb, 0xa7, 0xae, 0x43, 0x0e, 0xd6,
+	0x7d, 0x75, 0x22, 0x85, 0x4a, 0xed, 0x19, 0x8d, 0xf6, 0x27, 0x78, 0x31, 0x86, 0x54, 0xc8, 0xa4,
+	0x31, 0xfd, 0xe1, 0xd4, 0x60, 0x85, 0xec, 0x11, 0xff, 0x09, 0xc2, 0x67, 0xa2, 0xb8, 0xd2, 0xd7,
+	0x58, 0xb3, 0xe9, 0x3b, 0x2c, 0xe2, 0xee, 0xf8, 0xca, 0xbe, 0x05, 0x1c, 0xe7, 0x21, 0xab, 0xa7,
+	0xf5, 0xb3, 0xa5, 0xe5, 0x59, 0xa1, 0x5d, 0x55, 0xca, 0x84, 0xe3, 0x93, 0xc2, 0x47, 0xdc, 0x85,
+	0xa2, 0x70, 0x2d, 0x4b, 0xf3, 0xb6, 0x2a, 0x55, 0xad, 0x79, 0xb0, 0x70, 0x56, 0x3b, 0x05, 0xee,
+	0x52, 0x5b, 0x61, 0x93, 0xf7, 0xf1, 0xc5, 0xed, 0x6e, 0xb3, 0xb9, 0x5b, 0x83, 0x0f, 0x35, 0xb8,
+	0x23, 0x3c, 0x14, 0x05, 0x71, 0xca, 0xa2, 0x07, 0xfb, 0xe5, 0x92, 0xd4, 0x1e, 0x21, 0x48, 0xed,
+	0x0b, 0x62, 0x05, 0xf6, 0xac, 0xaa, 0xef, 0xe4, 0x23, 0x84, 0xcf, 0x05, 0xbc, 0xc5, 0xbc, 0x76,
+	0xfc, 0x2e, 0xaa, 0xc3, 0x7b, 0x65, 0x6a, 0x9c, 0x57, 0xbf, 0x04, 0x36, 0x2f, 0xc8, 0x5d, 0x07,
+	0x10, 0xb2, 0xf9, 0xf5, 0x6c, 0xa2, 0xaf, 0xde, 0x2d, 0x9b, 0xfa, 0x2d, 0xdb, 0xf4, 0xc2, 0xc8,
+	0x0f, 0x76, 0xf3, 0xf5, 0x88, 0xbf, 0x47, 0xf8, 0x9c, 0x8e, 0xb2, 0xd6, 0x8e, 0x82, 0xdd, 0xfc,
+	0x49, 0xec, 0x9b, 0xf8, 0xb8, 0x70, 0x2e, 0xdc, 0x80, 0xe5, 0xcc, 0x07, 0x6e, 0xcd, 0xf5, 0x75,
+	0x07, 0xf1, 0xc7, 0xb8, 0x83, 0x11, 0x7f, 0x7f, 0x56, 0xd0, 0x6f, 0x7e, 0x42, 0x1c, 0x2e, 0x80,
+	0x87, 0x4f, 0xde, 0x91, 0x9f, 0x20, 0xee, 0xaf, 0x67, 0xb8, 0xf3, 0x3a, 0xff, 0xfe, 0x98, 0x03,
+	0x54, 0x6a, 0x2b, 0x7c, 0xf2, 0x21, 0xc2, 0x45, 0x79, 0x57, 0xd2, 0x11, 0x7e, 0x48, 0x4c, 0x6c,
+	0xa6, 0xb3, 0x49, 0x4a, 0x3b, 0x5b, 0x3c, 0xcc, 0x44, 0x49, 0x3f, 0xcf, 0x5d, 0xfa, 0x2e, 0xb8,
+	0xe4, 0xeb, 0xf2, 0xf1, 0xbe, 0x26, 0xde, 0xee, 0xf9, 0x82, 0xe1, 0xd7, 0x05, 0x6c, 0x0c, 0xc3,
+	0x02, 0xff, 0xee, 0xe2, 0xd9, 0xf4, 0x84, 0x00, 0x72, 0xf8, 0x84, 0xd7, 0x3a, 0x05, 0x6a, 0x5d,
+	0x02, 0x77, 0x5c, 0x90, 0xc6, 0xa4, 0x81, 0xa9, 0x5d, 0xdc, 0xd1, 0xa5, 0xc9, 0xf7, 0x11, 0xc6,
+	0x01, 0x6f, 0x72, 0x16, 0xc6, 0x3d, 0xd9, 0x78, 0x67, 0xaf, 0x01, 0xfa, 0x39, 0x75, 0x01, 0x95,
+	0x6a, 0x36, 0x4f, 0x6b, 0x7b, 0xae, 0x7c, 0x3c, 0x8f, 0x8f, 0x0b, 0xe7, 0x90, 0x47, 0x08, 0xcf,
+	0x0d, 0x1b, 0x74, 0x90, 0xf5, 0xc9, 0x1c, 0x31, 0x6e, 0x9c, 0x62, 0x6c, 0x1c, 0x19, 0x47, 0x9e,
+	0x18, 0x7d, 0xfd, 0x07, 0x7f, 0xff, 0xcf, 0x2f, 0x0a, 0x57, 0x49, 0xc5, 0x1c, 0x31, 0xf1, 0x91,
+	0x53, 0x91, 0x1a, 0x93, 0xea, 0x2a, 0x3d, 0x91, 0xdf, 0x20, 0x7c, 0x42, 0xce, 0x2d, 0xc8, 0x1b,
+	0x19, 0x6c, 0x49, 0x8d, 0x51, 0x8c, 0x37, 0x73, 0x68, 0x82, 0xdd, 0x97, 0x85, 0xdd, 0x8b, 0xa4,
+	0x64, 0x1e, 0x3a, 0xce, 0x22, 0x7f, 0x46, 0x78, 0x5a, 0xab, 0xd8, 0xe4, 0x0b, 0x19, 0xb6, 0x1c,
+	0xec, 0xdd, 0x8c, 0x2f, 0xe6, 0x55, 0x9f, 0xd4, 0xdd, 0x7a, 0x0b, 0x61, 0xde, 0x83, 0x6b, 0xb7,
+	0x47, 0xfe, 0x8b, 0x30, 0x19, 0x1c, 0x43, 0x90, 0x5b, 0x59, 0xcd, 0x19, 0x36, 0x6f, 0x31, 0xd6,
+	0x8e, 0x88, 0x02, 0xdc, 0x36, 0x04, 0xb7, 0x2a, 0xb9, 0x71, 0x28, 0xb7, 0x58, 0xb7, 0xb6, 0xed,
+	0x07, 0x35, 0x39, 0x65, 0xe9, 0x71, 0x34, 0xef, 0xc9, 0x2f, 0x7b, 0xe4, 0x21, 0xc2, 0xb3, 0xe9,
+	0x81, 0x04, 0xb9, 0x99, 0xc1, 0xc4, 0xa1, 0x83, 0x11, 0xa3, 0x7a, 0x04, 0x04, 0x20, 0xf8, 0xa6,
+	0x20, 0x78, 0x8d, 0x2c, 0x8f, 0x22, 0xa8, 0x65, 0xec, 0x58, 0x51, 0x3b, 0xbf, 0x3f, 0x21, 0x3c,
+	0xa3, 0x4f, 0x18, 0x48, 0x96, 0x40, 0x1a, 0x32, 0x0a, 0x31, 0x6e, 0xe4, 0xd6, 0x07, 0x32, 0x57,
+	0x04, 0x99, 0x17, 0xc9, 0x0b, 0xe6, 0x24, 0xd3, 0x68, 0xf2, 0x2f, 0xd5, 0x05, 0xe8, 0x8f, 0x68,
+	0xb2, 0x9a, 0x27, 0x72, 0xfa, 0xc6, 0x23, 0xc6, 0xad, 0xa3, 0x81, 0x00, 0x9f, 0x77, 0x04, 0x9f,
+	0xd7, 0xc9, 0xe7, 0xc7, 0x47, 0x9f, 0x7a, 0x8f, 0x6b, 0xe7, 0xf3, 0x07, 0x84, 0x4f, 0x27, 0x6f,
+	0x66, 0xf2, 0x76, 0x16, 0x8b, 0xfa, 0xde, 0xf2, 0xc6, 0x3b, 0xf9, 0x94, 0x81, 0xc6, 0x4b, 0x82,
+	0xc6, 0xf3, 0xe4, 0x39, 0x73, 0xcc, 0xe0, 0x3f, 0x24, 0x7f, 0x44, 0xf8, 0x94, 0x02, 0x20, 0x6f,
+	0xe5, 0xd8, 0x55, 0x59, 0xfc, 0x76, 0x2e, 0x5d, 0x30, 0xb8, 0x22, 0x0c, 0x5e, 0x22, 0x97, 0xc7,
+	0x1a, 0x6c, 0xde, 0xf3, 0xdc, 0x3d, 0xf2, 0x3f, 0x84, 0xcf, 0x0f, 0x79, 0x91, 0x92, 0xb5, 0x1c,
+	0x46, 0x0c, 0x49, 0xd0, 0xeb, 0x47, 0x85, 0x01, 0x5a, 0x5f, 0x11, 0xb4, 0x36, 0xc9, 0xfa, 0x04,
+	0xb4, 0xb4, 0x5e, 0x77, 0x6f, 0x54, 0x02, 0x8f, 0x13, 0xc0, 0xaa, 0xfe, 0x1c, 0xcc, 0x59, 0x49,
+	0xc2, 0x3c, 0x09, 0x60, 0xd8, 0x2b, 0x78, 0x7c, 0x02, 0xd0, 0x99, 0x84, 0xfd, 0x19, 0x2c, 0xcc,
+	0x9b, 0xc1, 0xc2, 0x23, 0x66, 0xb0, 0x30, 0x5f, 0x06, 0x0b, 0xc9, 0x03, 0x75, 0x02, 0xd0, 0xc7,
+	0x67, 0x3f, 0x81, 0xf4, 0x33, 0xca, 0xb8, 0x91, 0x5b, 0x1f, 0x08, 0x5c, 0x17, 0x04, 0x96, 0x89,
+	0x79, 0xf8, 0x09, 0xc0, 0x8b, 0x42, 0x0b, 0xa6, 0xbf, 0x22, 0x5c, 0x4c, 0xf5, 0xca, 0x24, 0x8b,
+	0x2d, 0xc3, 0x9e, 0x01, 0xc6, 0xcd, 0xfc, 0x00, 0xc0, 0xe6, 0x0d, 0xc1, 0x66, 0x85, 0x5c, 0x35,
+	0x27, 0xfa, 0xbf, 0xc3, 0x1e, 0x1d, 0x6b, 0xeb, 0xc1, 0xe3, 0x12, 0x7a, 0xf8, 0xb8, 0x84, 0xfe,
+	0xfd, 0xb8, 0x84, 0xee, 0x3f, 0x29, 0x1d, 0x7b, 0xf8, 0xa4, 0x74, 0xec, 0x1f, 0x4f, 0x4a, 0xc7,
+	0xde, 0xbf, 0xaa, 0xf5, 0xe1, 0xd2, 0xbe, 0x2b, 0x60, 0x60, 0x6f, 0x93, 0xef, 0xc0, 0x36, 0xa2,
+	0x2b, 0xaf, 0x9f, 0x10, 0x73, 0xe9, 0x6b, 0xff, 0x1f, 0x00, 0x72, 0xda, 0x34, 0x05, 0x6f, 0x1e,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// this line is used by starport scaffolding # 2
+	ModuleAccountBalance(ctx context.Context, in *QueryModuleAccountBalanceRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalanceResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	ClaimRecord(ctx context.Context, in *QueryClaimRecordRequest, opts ...grpc.CallOption) (*QueryClaimRecordResponse, error)
+	ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error)
+	TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error)
+	AirdropState(ctx context.Context, in *QueryAirdropStateRequest, opts ...grpc.CallOption) (*QueryAirdropStateResponse, error)
+	ClaimableSchedule(ctx context.Context, in *QueryClaimableScheduleRequest, opts ...grpc.CallOption) (*QueryClaimableScheduleResponse, error)
+	Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error)
+	Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error)
+	CampaignClaimRecord(ctx context.Context, in *QueryCampaignClaimRecordRequest, opts ...grpc.CallOption) (*QueryCampaignClaimRecordResponse, error)
+	ClaimRecords(ctx context.Context, in *QueryClaimRecordsRequest, opts ...grpc.CallOption) (*QueryClaimRecordsResponse, error)
+	AirdropStats(ctx context.Context, in *QueryAirdropStatsRequest, opts ...grpc.CallOption) (*QueryAirdropStatsResponse, error)
+	ClaimHistory(ctx context.Context, in *QueryClaimHistoryRequest, opts ...grpc.CallOption) (*QueryClaimHistoryResponse, error)
+	VestingEscrow(ctx context.Context, in *QueryVestingEscrowRequest, opts ...grpc.CallOption) (*QueryVestingEscrowResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ModuleAccountBalance(ctx context.Context, in *QueryModuleAccountBalanceRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalanceResponse, error) {
+	out := new(QueryModuleAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/ModuleAccountBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimRecord(ctx context.Context, in *QueryClaimRecordRequest, opts ...grpc.CallOption) (*QueryClaimRecordResponse, error) {
+	out := new(QueryClaimRecordResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/ClaimRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error) {
+	out := new(QueryClaimableForActionResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/ClaimableForAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error) {
+	out := new(QueryTotalClaimableResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/TotalClaimable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AirdropState(ctx context.Context, in *QueryAirdropStateRequest, opts ...grpc.CallOption) (*QueryAirdropStateResponse, error) {
+	out := new(QueryAirdropStateResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/AirdropState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimableSchedule(ctx context.Context, in *QueryClaimableScheduleRequest, opts ...grpc.CallOption) (*QueryClaimableScheduleResponse, error) {
+	out := new(QueryClaimableScheduleResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/ClaimableSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error) {
+	out := new(QueryCampaignsResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/Campaigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error) {
+	out := new(QueryCampaignResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/Campaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CampaignClaimRecord(ctx context.Context, in *QueryCampaignClaimRecordRequest, opts ...grpc.CallOption) (*QueryCampaignClaimRecordResponse, error) {
+	out := new(QueryCampaignClaimRecordResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/CampaignClaimRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimRecords(ctx context.Context, in *QueryClaimRecordsRequest, opts ...grpc.CallOption) (*QueryClaimRecordsResponse, error) {
+	out := new(QueryClaimRecordsResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/ClaimRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AirdropStats(ctx context.Context, in *QueryAirdropStatsRequest, opts ...grpc.CallOption) (*QueryAirdropStatsResponse, error) {
+	out := new(QueryAirdropStatsResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/AirdropStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimHistory(ctx context.Context, in *QueryClaimHistoryRequest, opts ...grpc.CallOption) (*QueryClaimHistoryResponse, error) {
+	out := new(QueryClaimHistoryResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/ClaimHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingEscrow(ctx context.Context, in *QueryVestingEscrowRequest, opts ...grpc.CallOption) (*QueryVestingEscrowResponse, error) {
+	out := new(QueryVestingEscrowResponse)
+	err := c.cc.Invoke(ctx, "/publicawesome.stargaze.claim.v1beta1.Query/VestingEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// this line is used by starport scaffolding # 2
+	ModuleAccountBalance(context.Context, *QueryModuleAccountBalanceRequest) (*QueryModuleAccountBalanceResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	ClaimRecord(context.Context, *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error)
+	ClaimableForAction(context.Context, *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error)
+	TotalClaimable(context.Context, *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error)
+	AirdropState(context.Context, *QueryAirdropStateRequest) (*QueryAirdropStateResponse, error)
+	ClaimableSchedule(context.Context, *QueryClaimableScheduleRequest) (*QueryClaimableScheduleResponse, error)
+	Campaigns(context.Context, *QueryCampaignsRequest) (*QueryCampaignsResponse, error)
+	Campaign(context.Context, *QueryCampaignRequest) (*QueryCampaignResponse, error)
+	CampaignClaimRecord(context.Context, *QueryCampaignClaimRecordRequest) (*QueryCampaignClaimRecordResponse, error)
+	ClaimRecords(context.Context, *QueryClaimRecordsRequest) (*QueryClaimRecordsResponse, error)
+	AirdropStats(context.Context, *QueryAirdropStatsRequest) (*QueryAirdropStatsResponse, error)
+	ClaimHistory(context.Context, *QueryClaimHistoryRequest) (*QueryClaimHistoryResponse, error)
+	VestingEscrow(context.Context, *QueryVestingEscrowRequest) (*QueryVestingEscrowResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ModuleAccountBalance(ctx context.Context, req *QueryModuleAccountBalanceRequest) (*QueryModuleAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountBalance not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ClaimRecord(ctx context.Context, req *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRecord not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableForAction(ctx context.Context, req *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableForAction not implemented")
+}
+func (*UnimplementedQueryServer) TotalClaimable(ctx context.Context, req *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalClaimable not implemented")
+}
+func (*UnimplementedQueryServer) AirdropState(ctx context.Context, req *QueryAirdropStateRequest) (*QueryAirdropStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AirdropState not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableSchedule(ctx context.Context, req *QueryClaimableScheduleRequest) (*QueryClaimableScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableSchedule not implemented")
+}
+func (*UnimplementedQueryServer) Campaigns(ctx context.Context, req *QueryCampaignsRequest) (*QueryCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaigns not implemented")
+}
+func (*UnimplementedQueryServer) Campaign(ctx context.Context, req *QueryCampaignRequest) (*QueryCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaign not implemented")
+}
+func (*UnimplementedQueryServer) CampaignClaimRecord(ctx context.Context, req *QueryCampaignClaimRecordRequest) (*QueryCampaignClaimRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CampaignClaimRecord not implemented")
+}
+func (*UnimplementedQueryServer) ClaimRecords(ctx context.Context, req *QueryClaimRecordsRequest) (*QueryClaimRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRecords not implemented")
+}
+func (*UnimplementedQueryServer) AirdropStats(ctx context.Context, req *QueryAirdropStatsRequest) (*QueryAirdropStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AirdropStats not implemented")
+}
+func (*UnimplementedQueryServer) ClaimHistory(ctx context.Context, req *QueryClaimHistoryRequest) (*QueryClaimHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHistory not implemented")
+}
+func (*UnimplementedQueryServer) VestingEscrow(ctx context.Context, req *QueryVestingEscrowRequest) (*QueryVestingEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingEscrow not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ModuleAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/ModuleAccountBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccountBalance(ctx, req.(*QueryModuleAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/ClaimRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimRecord(ctx, req.(*QueryClaimRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableForAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableForActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableForAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/ClaimableForAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableForAction(ctx, req.(*QueryClaimableForActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalClaimableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalClaimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/TotalClaimable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalClaimable(ctx, req.(*QueryTotalClaimableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AirdropState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAirdropStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AirdropState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/AirdropState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AirdropState(ctx, req.(*QueryAirdropStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/ClaimableSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableSchedule(ctx, req.(*QueryClaimableScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Campaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/Campaigns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaigns(ctx, req.(*QueryCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Campaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/Campaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaign(ctx, req.(*QueryCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CampaignClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignClaimRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CampaignClaimRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/CampaignClaimRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CampaignClaimRecord(ctx, req.(*QueryCampaignClaimRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/ClaimRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimRecords(ctx, req.(*QueryClaimRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AirdropStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAirdropStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AirdropStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/AirdropStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AirdropStats(ctx, req.(*QueryAirdropStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/ClaimHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimHistory(ctx, req.(*QueryClaimHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicawesome.stargaze.claim.v1beta1.Query/VestingEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingEscrow(ctx, req.(*QueryVestingEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "publicawesome.stargaze.claim.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModuleAccountBalance",
+			Handler:    _Query_ModuleAccountBalance_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ClaimRecord",
+			Handler:    _Query_ClaimRecord_Handler,
+		},
+		{
+			MethodName: "ClaimableForAction",
+			Handler:    _Query_ClaimableForAction_Handler,
+		},
+		{
+			MethodName: "TotalClaimable",
+			Handler:    _Query_TotalClaimable_Handler,
+		},
+		{
+			MethodName: "AirdropState",
+			Handler:    _Query_AirdropState_Handler,
+		},
+		{
+			MethodName: "ClaimableSchedule",
+			Handler:    _Query_ClaimableSchedule_Handler,
+		},
+		{
+			MethodName: "Campaigns",
+			Handler:    _Query_Campaigns_Handler,
+		},
+		{
+			MethodName: "Campaign",
+			Handler:    _Query_Campaign_Handler,
+		},
+		{
+			MethodName: "CampaignClaimRecord",
+			Handler:    _Query_CampaignClaimRecord_Handler,
+		},
+		{
+			MethodName: "ClaimRecords",
+			Handler:    _Query_ClaimRecords_Handler,
+		},
+		{
+			MethodName: "AirdropStats",
+			Handler:    _Query_AirdropStats_Handler,
+		},
+		{
+			MethodName: "ClaimHistory",
+			Handler:    _Query_ClaimHistory_Handler,
+		},
+		{
+			MethodName: "VestingEscrow",
+			Handler:    _Query_VestingEscrow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stargaze/claim/v1beta1/query.proto",
+}
+
+func (m *QueryModuleAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleAccountBalance) > 0 {
+		for iNdEx := len(m.ModuleAccountBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleAccountBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableForActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableForActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableForActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableForActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableForActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableForActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalClaimableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalClaimableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalClaimableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalClaimableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AirdropState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableAtTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableAtTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableAtTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Campaign.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignClaimRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignClaimRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignClaimRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignClaimRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClaimRecords) > 0 {
+		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingBalance) > 0 {
+		for iNdEx := len(m.RemainingBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FullyClaimedAddresses != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FullyClaimedAddresses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TotalAllocated) > 0 {
+		for iNdEx := len(m.TotalAllocated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAllocated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CampaignId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalClaimed) > 0 {
+		for iNdEx := len(m.TotalClaimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalClaimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Releasable) > 0 {
+		for iNdEx := len(m.Releasable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releasable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.VestingEscrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryModuleAccountBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleAccountBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleAccountBalance) > 0 {
+		for _, e := range m.ModuleAccountBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClaimRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimableForActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovQuery(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *QueryClaimableForActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalClaimableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalClaimableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAirdropStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAirdropStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AirdropState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimableScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *ClaimableAtTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClaimableScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCampaignsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Campaign.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCampaignClaimRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignClaimRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClaimRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimRecords) > 0 {
+		for _, e := range m.ClaimRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAirdropStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAirdropStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalAllocated) > 0 {
+		for _, e := range m.TotalAllocated {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FullyClaimedAddresses != 0 {
+		n += 1 + sovQuery(uint64(m.FullyClaimedAddresses))
+	}
+	if len(m.RemainingBalance) > 0 {
+		for _, e := range m.RemainingBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClaimHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignId))
+	}
+	l = m.Claim.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalClaimed) > 0 {
+		for _, e := range m.TotalClaimed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVestingEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VestingEscrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Releasable) > 0 {
+		for _, e := range m.Releasable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryModuleAccountBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccountBalance = append(m.ModuleAccountBalance, types.Coin{})
+			if err := m.ModuleAccountBalance[len(m.ModuleAccountBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableForActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableForActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableForActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableForActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalClaimableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalClaimableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalClaimableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalClaimableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalClaimableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAirdropStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAirdropStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AirdropState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableAtTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableAtTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableAtTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, ClaimableAtTime{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Campaign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignClaimRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignClaimRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryClaimRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryClaimRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ClaimRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryClaimableForActionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Action != 0 {
-		n += 1 + sovQuery(uint64(m.Action))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryClaimableForActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCampaignClaimRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *QueryTotalClaimableRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryTotalClaimableResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignClaimRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryModuleAccountBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1249,12 +5499,48 @@ func (m *QueryModuleAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1276,7 +5562,7 @@ func (m *QueryModuleAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1295,19 +5581,53 @@ func (m *QueryModuleAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountBalanceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRecords = append(m.ClaimRecords, ClaimRecord{})
+			if err := m.ClaimRecords[len(m.ClaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1334,8 +5654,10 @@ func (m *QueryModuleAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAccountBalance = append(m.ModuleAccountBalance, types.Coin{})
-			if err := m.ModuleAccountBalance[len(m.ModuleAccountBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1360,7 +5682,7 @@ func (m *QueryModuleAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAirdropStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1383,10 +5705,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAirdropStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAirdropStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1410,7 +5732,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAirdropStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1433,15 +5755,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAirdropStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAirdropStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1468,7 +5790,95 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalAllocated = append(m.TotalAllocated, types.Coin{})
+			if err := m.TotalAllocated[len(m.TotalAllocated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, ActionClaimed{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullyClaimedAddresses", wireType)
+			}
+			m.FullyClaimedAddresses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FullyClaimedAddresses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingBalance = append(m.RemainingBalance, types.Coin{})
+			if err := m.RemainingBalance[len(m.RemainingBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1493,7 +5903,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1516,10 +5926,10 @@ func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1575,7 +5985,7 @@ func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1598,15 +6008,34 @@ func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1633,7 +6062,7 @@ func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1658,7 +6087,7 @@ func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1681,17 +6110,17 @@ func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimableForActionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimableForActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1701,29 +6130,31 @@ func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.History = append(m.History, ClaimHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
 			}
-			m.Action = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1733,11 +6164,26 @@ func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Action |= Action(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalClaimed = append(m.TotalClaimed, types.Coin{})
+			if err := m.TotalClaimed[len(m.TotalClaimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1759,7 +6205,7 @@ func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1782,17 +6228,17 @@ func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimableForActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimableForActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1802,25 +6248,23 @@ func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1843,7 +6287,7 @@ func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalClaimableRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1866,17 +6310,17 @@ func (m *QueryTotalClaimableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalClaimableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalClaimableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingEscrow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1886,77 +6330,28 @@ func (m *QueryTotalClaimableRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.VestingEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalClaimableResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalClaimableResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Releasable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1983,8 +6378,8 @@ func (m *QueryTotalClaimableResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Releasable = append(m.Releasable, types.Coin{})
+			if err := m.Releasable[len(m.Releasable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
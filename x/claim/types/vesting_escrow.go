@@ -0,0 +1,96 @@
+package types
+
+import (
+	fmt "fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewVestingSchedule returns a schedule releasing an amount continuously from start until start plus duration
+func NewVestingSchedule(amount sdk.Coins, start time.Time, duration time.Duration) VestingSchedule {
+	return VestingSchedule{
+		Amount:    amount,
+		StartTime: start,
+		EndTime:   start.Add(duration),
+		Released:  sdk.Coins{},
+	}
+}
+
+// Vested returns the amount vested at the given time
+func (s VestingSchedule) Vested(t time.Time) sdk.Coins {
+	if !t.After(s.StartTime) {
+		return sdk.Coins{}
+	}
+	if !t.Before(s.EndTime) {
+		return s.Amount
+	}
+	fraction := sdk.NewDec(t.Sub(s.StartTime).Nanoseconds()).QuoInt64(s.EndTime.Sub(s.StartTime).Nanoseconds())
+	vested := sdk.Coins{}
+	for _, coin := range s.Amount {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(fraction).TruncateInt()))
+	}
+	return vested
+}
+
+// Releasable returns the amount vested at the given time and not released yet
+func (s VestingSchedule) Releasable(t time.Time) sdk.Coins {
+	return s.Vested(t).Sub(s.Released)
+}
+
+// Releasable returns the amount of all schedules vested at the given time and not released yet
+func (e VestingEscrow) Releasable(t time.Time) sdk.Coins {
+	releasable := sdk.Coins{}
+	for _, schedule := range e.Schedules {
+		releasable = releasable.Add(schedule.Releasable(t)...)
+	}
+	return releasable
+}
+
+// Unreleased returns the amount of all schedules not released yet
+func (e VestingEscrow) Unreleased() sdk.Coins {
+	unreleased := sdk.Coins{}
+	for _, schedule := range e.Schedules {
+		unreleased = unreleased.Add(schedule.Amount.Sub(schedule.Released)...)
+	}
+	return unreleased
+}
+
+// Release marks the vested amount as released, drops the fully released schedules
+// and returns the released amount
+func (e *VestingEscrow) Release(t time.Time) sdk.Coins {
+	released := sdk.Coins{}
+	schedules := []VestingSchedule{}
+	for _, schedule := range e.Schedules {
+		releasable := schedule.Releasable(t)
+		released = released.Add(releasable...)
+		schedule.Released = schedule.Released.Add(releasable...)
+		if !schedule.Released.IsEqual(schedule.Amount) {
+			schedules = append(schedules, schedule)
+		}
+	}
+	e.Schedules = schedules
+	return released
+}
+
+// Validate performs stateless validation of the vesting escrow
+func (e VestingEscrow) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return err
+	}
+	for _, schedule := range e.Schedules {
+		if err := schedule.Amount.Validate(); err != nil {
+			return err
+		}
+		if err := schedule.Released.Validate(); err != nil {
+			return err
+		}
+		if !schedule.Amount.IsAllGTE(schedule.Released) {
+			return fmt.Errorf("vesting escrow of %s released %s out of %s", e.Address, schedule.Released, schedule.Amount)
+		}
+		if schedule.EndTime.Before(schedule.StartTime) {
+			return fmt.Errorf("vesting escrow of %s ends before it starts", e.Address)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,753 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stargaze/claim/v1beta1/vesting_escrow.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VestingEscrow holds the vesting part of the claims of an address whose
+// account already existed. The coins are held by the claim module account.
+type VestingEscrow struct {
+	Address   string            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Schedules []VestingSchedule `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules" yaml:"schedules"`
+}
+
+func (m *VestingEscrow) Reset()         { *m = VestingEscrow{} }
+func (m *VestingEscrow) String() string { return proto.CompactTextString(m) }
+func (*VestingEscrow) ProtoMessage()    {}
+func (*VestingEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc6f680a0c2ae6c1, []int{0}
+}
+func (m *VestingEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingEscrow.Merge(m, src)
+}
+func (m *VestingEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingEscrow proto.InternalMessageInfo
+
+func (m *VestingEscrow) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VestingEscrow) GetSchedules() []VestingSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+// VestingSchedule releases an amount continuously between start and end time
+type VestingSchedule struct {
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	StartTime time.Time                                `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time                                `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// amount already released to the address
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released" yaml:"released"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc6f680a0c2ae6c1, []int{1}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func (m *VestingSchedule) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *VestingSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *VestingSchedule) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *VestingSchedule) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*VestingEscrow)(nil), "publicawesome.stargaze.claim.v1beta1.VestingEscrow")
+	proto.RegisterType((*VestingSchedule)(nil), "publicawesome.stargaze.claim.v1beta1.VestingSchedule")
+}
+
+func init() {
+	proto.RegisterFile("stargaze/claim/v1beta1/vesting_escrow.proto", fileDescriptor_bc6f680a0c2ae6c1)
+}
+
+var fileDescriptor_bc6f680a0c2ae6c1 = []byte{
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xbf, 0x6e, 0xd5, 0x30,
+	0x14, 0xc6, 0xe3, 0x5e, 0xd4, 0xf6, 0xba, 0x2a, 0x85, 0x88, 0x21, 0x5c, 0x44, 0x72, 0x15, 0x31,
+	0x44, 0x82, 0xda, 0xb4, 0x88, 0x85, 0x8d, 0x54, 0x2c, 0x8c, 0x01, 0x21, 0xc4, 0x52, 0x39, 0xc9,
+	0x21, 0x8d, 0x88, 0xe3, 0x28, 0x76, 0x5a, 0xda, 0xa7, 0xe8, 0x73, 0x20, 0x16, 0xde, 0xa2, 0x63,
+	0x47, 0xa6, 0x5b, 0x74, 0xef, 0x1b, 0xf4, 0x09, 0x50, 0x6c, 0x27, 0xfc, 0x59, 0x0a, 0x53, 0xfe,
+	0xd8, 0xdf, 0xef, 0x3b, 0xdf, 0x39, 0x36, 0x7e, 0x2c, 0x15, 0x6b, 0x0b, 0x76, 0x06, 0x34, 0xab,
+	0x58, 0xc9, 0xe9, 0xf1, 0x5e, 0x0a, 0x8a, 0xed, 0xd1, 0x63, 0x90, 0xaa, 0xac, 0x8b, 0x43, 0x90,
+	0x59, 0x2b, 0x4e, 0x48, 0xd3, 0x0a, 0x25, 0xdc, 0x47, 0x4d, 0x97, 0x56, 0x65, 0xc6, 0x4e, 0x40,
+	0x0a, 0x0e, 0x64, 0x90, 0x12, 0x2d, 0x25, 0x56, 0x3a, 0xbb, 0x57, 0x88, 0x42, 0x68, 0x01, 0xed,
+	0xdf, 0x8c, 0x76, 0x16, 0x14, 0x42, 0x14, 0x15, 0x50, 0xfd, 0x95, 0x76, 0x1f, 0xa9, 0x2a, 0x39,
+	0x48, 0xc5, 0x78, 0x63, 0x37, 0xf8, 0x99, 0x90, 0x5c, 0x48, 0x9a, 0x32, 0x09, 0x63, 0x19, 0x99,
+	0x28, 0x6b, 0xb3, 0x1e, 0x7e, 0x45, 0x78, 0xfb, 0x9d, 0xa9, 0xea, 0x95, 0x2e, 0xca, 0x7d, 0x82,
+	0x37, 0x58, 0x9e, 0xb7, 0x20, 0xa5, 0x87, 0xe6, 0x28, 0x9a, 0xc6, 0xee, 0xf5, 0x22, 0xb8, 0x7d,
+	0xca, 0x78, 0xf5, 0x22, 0xb4, 0x0b, 0x61, 0x32, 0x6c, 0x71, 0x39, 0x9e, 0xca, 0xec, 0x08, 0xf2,
+	0xae, 0x02, 0xe9, 0xad, 0xcd, 0x27, 0xd1, 0xd6, 0xfe, 0x73, 0xf2, 0x2f, 0x81, 0x88, 0x75, 0x7d,
+	0x63, 0xd5, 0xb1, 0x77, 0xb1, 0x08, 0x9c, 0xeb, 0x45, 0x70, 0xc7, 0x58, 0x8d, 0xd4, 0x30, 0xf9,
+	0xe5, 0x10, 0x7e, 0x9b, 0xe0, 0x9d, 0xbf, 0x84, 0xae, 0xc2, 0xeb, 0x8c, 0x8b, 0xae, 0x56, 0x1e,
+	0xd2, 0xfe, 0xf7, 0x89, 0xc9, 0x4c, 0xfa, 0xcc, 0xa3, 0xdd, 0x81, 0x28, 0xeb, 0xf8, 0xa5, 0xf5,
+	0xd8, 0xb6, 0x71, 0xb4, 0x2c, 0xfc, 0x72, 0x15, 0x44, 0x45, 0xa9, 0x8e, 0xba, 0x94, 0x64, 0x82,
+	0x53, 0xdb, 0x31, 0xf3, 0xd8, 0x95, 0xf9, 0x27, 0xaa, 0x4e, 0x1b, 0x90, 0x9a, 0x20, 0x13, 0xeb,
+	0xe5, 0xbe, 0xc7, 0xb8, 0x0f, 0xa6, 0x0e, 0xfb, 0x8e, 0x7b, 0x6b, 0x73, 0x14, 0x6d, 0xed, 0xcf,
+	0x88, 0x19, 0x07, 0x19, 0xc6, 0x41, 0xde, 0x0e, 0xe3, 0x88, 0x1f, 0x5a, 0xeb, 0xbb, 0x36, 0xde,
+	0xa8, 0x0d, 0xcf, 0xaf, 0x02, 0x94, 0x4c, 0xf5, 0x8f, 0x7e, 0xbb, 0x9b, 0xe0, 0x4d, 0xa8, 0x73,
+	0xc3, 0x9d, 0xdc, 0xc8, 0x7d, 0x60, 0xb9, 0x3b, 0x86, 0x3b, 0x28, 0x0d, 0x75, 0x03, 0xea, 0x5c,
+	0x33, 0xcf, 0xf0, 0x66, 0x0b, 0x15, 0x30, 0x09, 0xb9, 0x77, 0xeb, 0xa6, 0x2e, 0x1d, 0xfc, 0x89,
+	0x1c, 0x84, 0xff, 0xd7, 0xa7, 0xd1, 0x2f, 0x7e, 0x7d, 0xb1, 0xf4, 0xd1, 0xe5, 0xd2, 0x47, 0x3f,
+	0x96, 0x3e, 0x3a, 0x5f, 0xf9, 0xce, 0xe5, 0xca, 0x77, 0xbe, 0xaf, 0x7c, 0xe7, 0xc3, 0xd3, 0xdf,
+	0x68, 0xe6, 0xcc, 0xec, 0xda, 0x43, 0x43, 0xc7, 0x0b, 0xf4, 0xd9, 0x5e, 0x21, 0xcd, 0x4e, 0xd7,
+	0x75, 0x07, 0x9e, 0xfd, 0x1c, 0x00, 0x30, 0x0a, 0x26, 0x6d, 0x61, 0x03, 0x00, 0x00,
+}
+
+func (m *VestingEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVestingEscrow(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintVestingEscrow(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintVestingEscrow(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVestingEscrow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVestingEscrow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VestingEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVestingEscrow(uint64(l))
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovVestingEscrow(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVestingEscrow(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovVestingEscrow(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovVestingEscrow(uint64(l))
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovVestingEscrow(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVestingEscrow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVestingEscrow(x uint64) (n int) {
+	return sovVestingEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VestingEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, VestingSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVestingEscrow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVestingEscrow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVestingEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVestingEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVestingEscrow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVestingEscrow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVestingEscrow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVestingEscrow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVestingEscrow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVestingEscrow = fmt.Errorf("proto: unexpected end of group")
+)
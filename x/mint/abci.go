@@ -13,27 +13,49 @@ import (
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	// switch to a scheduled minting schedule once it takes effect
+	k.ApplyPendingScheduleUpdate(ctx)
+
 	// fetch stored minter & params
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
 	// recalculate annual provision
 	minter.AnnualProvisions = minter.NextAnnualProvisions(ctx.BlockTime(), params)
-	k.SetMinter(ctx, minter)
 
 	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
+	mintedCoin := minter.Provision(ctx.BlockTime(), params)
+
+	// clamp the last provision to the max supply, nothing is minted once reached
+	if remaining, capped := k.GetRemainingSupply(ctx); capped && mintedCoin.Amount.GT(remaining) {
+		mintedCoin.Amount = remaining
+	}
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
+	minter.PrevBlockTime = ctx.BlockTime()
+	minter.TotalMinted = minter.TotalMinted.Add(mintedCoin.Amount)
+	minter.PeakSupply = k.GetPeakSupply(ctx).Add(mintedCoin.Amount)
+	k.SetMinter(ctx, minter)
+
 	err := k.MintCoins(ctx, mintedCoins)
 	if err != nil {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
-	if err != nil {
-		panic(err)
+	// send the minted coins to the mint destinations, falling back to the fee collector
+	// so a destination which can't receive coins doesn't halt the chain
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	err = k.DistributeMintedCoins(cacheCtx, mintedCoins)
+	if err == nil {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	} else {
+		k.Logger(ctx).Error("failed to distribute minted coins, sending them to the fee collector", "error", err)
+		err = k.AddCollectedFees(ctx, mintedCoins)
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	if mintedCoin.Amount.IsInt64() {
@@ -0,0 +1,172 @@
+package mint_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/public-awesome/stargaze/testutil/sample"
+	"github.com/public-awesome/stargaze/testutil/simapp"
+	"github.com/public-awesome/stargaze/x/mint"
+	"github.com/public-awesome/stargaze/x/mint/types"
+)
+
+func TestBeginBlockerBlockTimeProvisioning(t *testing.T) {
+	app := simapp.New(t.TempDir())
+	start := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+
+	params := types.DefaultParams()
+	params.StartTime = start.Add(-time.Hour)
+	params.InitialAnnualProvisions = sdk.NewDec(365 * 24 * 60 * 60)
+	params.ProvisioningMode = types.ProvisioningModeBlockTime
+	params.MaxBlockDuration = time.Minute
+	app.MintKeeper.SetParams(ctx, params)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := func(ctx sdk.Context) int64 {
+		return app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount.Int64()
+	}
+
+	// nothing is minted without a previous block time
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Equal(t, int64(0), collected(ctx))
+	require.True(t, start.Equal(app.MintKeeper.GetMinter(ctx).PrevBlockTime))
+
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(start.Add(6 * time.Second))
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Equal(t, int64(6), collected(ctx))
+
+	// a halt only mints the max block duration
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(start.Add(6*time.Second + 2*time.Hour))
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Equal(t, int64(66), collected(ctx))
+	require.True(t, ctx.BlockTime().Equal(app.MintKeeper.GetMinter(ctx).PrevBlockTime))
+}
+
+func TestBeginBlockerMaxSupply(t *testing.T) {
+	app := simapp.New(t.TempDir())
+	start := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+
+	params := types.DefaultParams()
+	params.StartTime = start.Add(-time.Hour)
+	params.InitialAnnualProvisions = sdk.NewDec(int64(params.BlocksPerYear) * 100)
+	supply := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	params.MaxSupply = supply.AddRaw(250)
+	app.MintKeeper.SetParams(ctx, params)
+
+	for _, expected := range []int64{100, 200, 250, 250} {
+		mint.BeginBlocker(ctx, app.MintKeeper)
+		require.Equal(t, sdk.NewInt(expected), app.MintKeeper.GetMinter(ctx).TotalMinted)
+		require.Equal(t, supply.AddRaw(expected), app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+
+	remaining, capped := app.MintKeeper.GetRemainingSupply(ctx)
+	require.True(t, capped)
+	require.True(t, remaining.IsZero())
+
+	// burns don't reopen the cap
+	burned := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 100))
+	err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, govtypes.ModuleName, burned)
+	require.NoError(t, err)
+	err = app.BankKeeper.BurnCoins(ctx, govtypes.ModuleName, burned)
+	require.NoError(t, err)
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Equal(t, sdk.NewInt(250), app.MintKeeper.GetMinter(ctx).TotalMinted)
+	require.Equal(t, supply.AddRaw(150), app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	remaining, capped = app.MintKeeper.GetRemainingSupply(ctx)
+	require.True(t, capped)
+	require.True(t, remaining.IsZero())
+}
+
+func TestBeginBlockerMintDestinations(t *testing.T) {
+	app := simapp.New(t.TempDir())
+	start := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+
+	address := sample.AccAddress()
+	params := types.DefaultParams()
+	params.StartTime = start.Add(-time.Hour)
+	params.InitialAnnualProvisions = sdk.NewDec(int64(params.BlocksPerYear) * 101)
+	params.MintDestinations = []types.WeightedMintDestination{
+		{Module: authtypes.FeeCollectorName, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Module: distrtypes.ModuleName, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Address: address, Weight: sdk.NewDecWithPrec(2, 1)},
+	}
+	app.MintKeeper.SetParams(ctx, params)
+
+	balance := func(addr sdk.AccAddress) sdk.Int {
+		return app.BankKeeper.GetBalance(ctx, addr, params.MintDenom).Amount
+	}
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	distribution := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	addr, err := sdk.AccAddressFromBech32(address)
+	require.NoError(t, err)
+	feeCollectorBalance, distributionBalance := balance(feeCollector), balance(distribution)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	// the last destination receives the rounding remainder
+	require.Equal(t, feeCollectorBalance.AddRaw(50), balance(feeCollector))
+	require.Equal(t, distributionBalance.AddRaw(30), balance(distribution))
+	require.Equal(t, sdk.NewInt(21), balance(addr))
+
+	events := []*types.EventMintDestination{}
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if event, ok := msg.(*types.EventMintDestination); ok {
+			events = append(events, event)
+		}
+	}
+	require.Equal(t, []*types.EventMintDestination{
+		{Module: authtypes.FeeCollectorName, Address: feeCollector.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 50))},
+		{Module: distrtypes.ModuleName, Address: distribution.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 30))},
+		{Address: address, Amount: sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 21))},
+	}, events)
+}
+
+func TestBeginBlockerMintDestinationsFallback(t *testing.T) {
+	app := simapp.New(t.TempDir())
+	start := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+
+	// destinations which can't receive coins are stored bypassing the keeper checks
+	blocked := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	params := types.DefaultParams()
+	params.StartTime = start.Add(-time.Hour)
+	params.InitialAnnualProvisions = sdk.NewDec(int64(params.BlocksPerYear) * 100)
+	params.MintDestinations = []types.WeightedMintDestination{
+		{Module: authtypes.FeeCollectorName, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Address: blocked.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	app.GetSubspace(types.ModuleName).SetParamSet(ctx, &params)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount
+	distributionBalance := app.BankKeeper.GetBalance(ctx, blocked, params.MintDenom).Amount
+
+	// everything is sent to the fee collector instead of halting the chain
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { mint.BeginBlocker(ctx, app.MintKeeper) })
+	require.Equal(t, feeCollectorBalance.AddRaw(100), app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount)
+	require.Equal(t, distributionBalance, app.BankKeeper.GetBalance(ctx, blocked, params.MintDenom).Amount)
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err == nil {
+			_, ok := msg.(*types.EventMintDestination)
+			require.False(t, ok)
+		}
+	}
+}
@@ -23,6 +23,12 @@ func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryTotalMinted(),
+		GetCmdQueryRemainingSupply(),
+		GetCmdQueryInflation(),
+		GetCmdQueryStakingAPR(),
+		GetCmdQuerySchedule(),
+		GetCmdQueryPendingScheduleUpdate(),
 	)
 
 	return mintingQueryCmd
@@ -87,3 +93,183 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryTotalMinted implements a command to return the total amount
+// minted since the start time.
+func GetCmdQueryTotalMinted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-minted",
+		Short: "Query the total amount minted since the start time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTotalMintedRequest{}
+			res, err := queryClient.TotalMinted(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.TotalMinted))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRemainingSupply implements a command to return the amount which
+// can still be minted before reaching the max supply.
+func GetCmdQueryRemainingSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remaining-supply",
+		Short: "Query the amount which can still be minted before reaching the max supply",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRemainingSupplyRequest{}
+			res, err := queryClient.RemainingSupply(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.RemainingSupply))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInflation implements a command to return the current annual
+// provisions relative to the current supply.
+func GetCmdQueryInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation",
+		Short: "Query the current inflation rate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryInflationRequest{}
+			res, err := queryClient.Inflation(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.Inflation))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStakingAPR implements a command to return the current annual
+// percentage rate earned by stakers.
+func GetCmdQueryStakingAPR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-apr",
+		Short: "Query the current annual percentage rate earned by stakers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryStakingAPRRequest{}
+			res, err := queryClient.StakingAPR(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.StakingAPR))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySchedule implements a command to return the projected annual
+// provisions of the current and each future year.
+func GetCmdQuerySchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Query the projected annual provisions of the current and each future year",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryScheduleRequest{}
+			res, err := queryClient.Schedule(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingScheduleUpdate implements a command to return the schedule
+// update waiting for its effective time.
+func GetCmdQueryPendingScheduleUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-schedule-update",
+		Short: "Query the minting schedule update waiting for its effective time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingScheduleUpdateRequest{}
+			res, err := queryClient.PendingScheduleUpdate(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.ScheduleUpdate)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/public-awesome/stargaze/x/mint/types"
+)
+
+// CmdSubmitScheduleUpdateProposal implements the command to submit a minting schedule update proposal
+func CmdSubmitScheduleUpdateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-schedule-update [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to change the minting schedule from a future effective time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to change the initial annual provisions and reduction factor of the minting
+schedule from a future effective time along with an initial deposit. The year count since the start time continues,
+the new initial annual provisions apply to the year the update takes effect in.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal mint-schedule-update <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Mint schedule update",
+  "description": "Lower the annual provisions from the next year",
+  "effective_time": "2023-01-01T00:00:00Z",
+  "initial_annual_provisions": "500000000000000.000000000000000000",
+  "reduction_factor": "0.750000000000000000",
+  "deposit": "1000ustars"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := parseScheduleUpdateProposalWithDeposit(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewScheduleUpdateProposal(
+				proposal.Title, proposal.Description, proposal.EffectiveTime,
+				proposal.InitialAnnualProvisions, proposal.ReductionFactor,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// parseScheduleUpdateProposalWithDeposit reads and parses a ScheduleUpdateProposalWithDeposit from a file.
+func parseScheduleUpdateProposalWithDeposit(cdc codec.JSONCodec, proposalFile string) (types.ScheduleUpdateProposalWithDeposit, error) {
+	proposal := types.ScheduleUpdateProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
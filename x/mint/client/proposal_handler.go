@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/public-awesome/stargaze/x/mint/client/cli"
+	"github.com/public-awesome/stargaze/x/mint/client/rest"
+)
+
+// ProposalHandler is the minting schedule update proposal handler.
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitScheduleUpdateProposal, rest.ProposalRESTHandler)
+)
@@ -0,0 +1,61 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/public-awesome/stargaze/x/mint/types"
+)
+
+// ScheduleUpdateProposalReq defines a minting schedule update proposal request body.
+type ScheduleUpdateProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title                   string         `json:"title" yaml:"title"`
+	Description             string         `json:"description" yaml:"description"`
+	EffectiveTime           time.Time      `json:"effective_time" yaml:"effective_time"`
+	InitialAnnualProvisions sdk.Dec        `json:"initial_annual_provisions" yaml:"initial_annual_provisions"`
+	ReductionFactor         sdk.Dec        `json:"reduction_factor" yaml:"reduction_factor"`
+	Proposer                sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit                 sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the minting schedule update REST handler with a given sub-route.
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "mint_schedule_update",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ScheduleUpdateProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewScheduleUpdateProposal(req.Title, req.Description, req.EffectiveTime, req.InitialAnnualProvisions, req.ReductionFactor)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
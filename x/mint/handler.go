@@ -0,0 +1,47 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/public-awesome/stargaze/x/mint/keeper"
+	"github.com/public-awesome/stargaze/x/mint/types"
+)
+
+// NewScheduleUpdateProposalHandler handles the mint module governance proposals
+func NewScheduleUpdateProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ScheduleUpdateProposal:
+			return keeper.HandleScheduleUpdateProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+// NewParamChangeProposalHandler wraps the params module proposal handler and rejects param
+// changes to mint destinations which can't receive coins.
+func NewParamChangeProposalHandler(k keeper.Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+		c, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range c.Changes {
+			if change.Subspace != types.ModuleName {
+				continue
+			}
+			if err := k.ValidateParams(ctx); err != nil {
+				return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "invalid %s params: %s", types.ModuleName, err)
+			}
+			break
+		}
+		return nil
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/public-awesome/stargaze/x/mint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -24,3 +26,62 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// TotalMinted returns minter.TotalMinted of the mint module.
+func (k Keeper) TotalMinted(c context.Context, _ *types.QueryTotalMintedRequest) (*types.QueryTotalMintedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+
+	return &types.QueryTotalMintedResponse{TotalMinted: minter.TotalMinted}, nil
+}
+
+// RemainingSupply returns the amount which can still be minted before reaching the max supply.
+func (k Keeper) RemainingSupply(c context.Context, _ *types.QueryRemainingSupplyRequest) (*types.QueryRemainingSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	remaining, capped := k.GetRemainingSupply(ctx)
+	if !capped {
+		return nil, status.Error(codes.NotFound, "max supply is not set")
+	}
+
+	return &types.QueryRemainingSupplyResponse{RemainingSupply: remaining}, nil
+}
+
+// Inflation returns the current annual provisions, clamped to the remaining supply, relative
+// to the current supply.
+func (k Keeper) Inflation(c context.Context, _ *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryInflationResponse{Inflation: k.GetInflation(ctx)}, nil
+}
+
+// StakingAPR returns the current annual percentage rate earned by stakers.
+func (k Keeper) StakingAPR(c context.Context, _ *types.QueryStakingAPRRequest) (*types.QueryStakingAPRResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryStakingAPRResponse{StakingAPR: k.GetStakingAPR(ctx)}, nil
+}
+
+// Schedule returns the projected annual provisions of the current and each future year, including
+// the pending schedule update from the year it takes effect in.
+func (k Keeper) Schedule(c context.Context, _ *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+	var pending *types.ScheduleUpdate
+	if update, found := k.GetPendingScheduleUpdate(ctx); found {
+		pending = &update
+	}
+
+	return &types.QueryScheduleResponse{Schedule: minter.Schedule(ctx.BlockTime(), params, pending, types.MaxScheduleYears)}, nil
+}
+
+// PendingScheduleUpdate returns the schedule update waiting for its effective time.
+func (k Keeper) PendingScheduleUpdate(c context.Context, _ *types.QueryPendingScheduleUpdateRequest) (*types.QueryPendingScheduleUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	update, found := k.GetPendingScheduleUpdate(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no pending schedule update")
+	}
+
+	return &types.QueryPendingScheduleUpdateResponse{ScheduleUpdate: update}, nil
+}
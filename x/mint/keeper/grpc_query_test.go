@@ -9,6 +9,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/public-awesome/stargaze/app"
 	"github.com/public-awesome/stargaze/x/mint/types"
 )
@@ -47,6 +50,146 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCSupply() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	minter.TotalMinted = sdk.NewInt(1000)
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	totalMinted, err := queryClient.TotalMinted(gocontext.Background(), &types.QueryTotalMintedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), totalMinted.TotalMinted)
+
+	// no max supply
+	_, err = queryClient.RemainingSupply(gocontext.Background(), &types.QueryRemainingSupplyRequest{})
+	suite.Require().Error(err)
+
+	params := app.MintKeeper.GetParams(ctx)
+	err = app.MintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)))
+	suite.Require().NoError(err)
+	supply := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	params.MaxSupply = supply.AddRaw(500)
+	app.MintKeeper.SetParams(ctx, params)
+
+	remaining, err := queryClient.RemainingSupply(gocontext.Background(), &types.QueryRemainingSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(500), remaining.RemainingSupply)
+
+	// supply above the max supply
+	params.MaxSupply = supply.SubRaw(1)
+	app.MintKeeper.SetParams(ctx, params)
+
+	remaining, err = queryClient.RemainingSupply(gocontext.Background(), &types.QueryRemainingSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(remaining.RemainingSupply.IsZero())
+}
+
+func (suite *MintTestSuite) TestGRPCInflation() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	// no supply
+	inflation, err := queryClient.Inflation(gocontext.Background(), &types.QueryInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(inflation.Inflation.IsZero())
+
+	params := app.MintKeeper.GetParams(ctx)
+	err = app.MintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)))
+	suite.Require().NoError(err)
+	supply := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	minter.AnnualProvisions = supply.ToDec().QuoInt64(5)
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	inflation, err = queryClient.Inflation(gocontext.Background(), &types.QueryInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(2, 1), inflation.Inflation)
+
+	// nothing bonded
+	apr, err := queryClient.StakingAPR(gocontext.Background(), &types.QueryStakingAPRRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(apr.StakingAPR.IsZero())
+
+	// bond half of the supply
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, stakingtypes.BondedPoolName,
+		sdk.NewCoins(sdk.NewCoin(params.MintDenom, supply.QuoRaw(2))))
+	suite.Require().NoError(err)
+
+	distrParams := app.DistrKeeper.GetParams(ctx)
+	distrParams.CommunityTax = sdk.NewDecWithPrec(1, 1)
+	app.DistrKeeper.SetParams(ctx, distrParams)
+
+	// 20% inflation * 40% left after the alloc proportions * 90% after community tax / 50% bonded
+	apr, err = queryClient.StakingAPR(gocontext.Background(), &types.QueryStakingAPRRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(144, 3), apr.StakingAPR)
+
+	// only the share sent to the fee collector reaches the stakers
+	params.MintDestinations = []types.WeightedMintDestination{
+		{Module: authtypes.FeeCollectorName, Weight: sdk.NewDecWithPrec(75, 2)},
+		{Module: distrtypes.ModuleName, Weight: sdk.NewDecWithPrec(25, 2)},
+	}
+	app.MintKeeper.SetParams(ctx, params)
+	apr, err = queryClient.StakingAPR(gocontext.Background(), &types.QueryStakingAPRRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(108, 3), apr.StakingAPR)
+
+	// provisions are clamped to the remaining supply
+	params.MaxSupply = supply.AddRaw(100)
+	app.MintKeeper.SetParams(ctx, params)
+	inflation, err = queryClient.Inflation(gocontext.Background(), &types.QueryInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 1), inflation.Inflation)
+	suite.Require().ErrorIs(app.MintKeeper.ValidateExternalMint(ctx, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 101))), types.ErrMaxSupplyExceeded)
+	suite.Require().NoError(app.MintKeeper.ValidateExternalMint(ctx, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 100), sdk.NewInt64Coin("uatom", 1000))))
+
+	// and there is no inflation once the max supply is reached
+	params.MaxSupply = supply
+	app.MintKeeper.SetParams(ctx, params)
+	inflation, err = queryClient.Inflation(gocontext.Background(), &types.QueryInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(inflation.Inflation.IsZero())
+	apr, err = queryClient.StakingAPR(gocontext.Background(), &types.QueryStakingAPRRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(apr.StakingAPR.IsZero())
+}
+
+func (suite *MintTestSuite) TestGRPCSchedule() {
+	app, ctx := suite.app, suite.ctx
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.InitialAnnualProvisions = sdk.NewDec(1000)
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	app.MintKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockTime(params.StartTime.AddDate(2, 0, 1))
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.MintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	schedule, err := queryClient.Schedule(gocontext.Background(), &types.QueryScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.MintKeeper.GetMinter(ctx).Schedule(ctx.BlockTime(), params, nil, types.MaxScheduleYears), schedule.Schedule)
+	suite.Require().Len(schedule.Schedule, 8)
+	suite.Require().Equal(uint64(2), schedule.Schedule[0].Year)
+	suite.Require().Equal(sdk.NewDec(250), schedule.Schedule[0].AnnualProvisions)
+
+	// the pending schedule update applies from the year it takes effect in
+	app.MintKeeper.SetPendingScheduleUpdate(ctx, types.ScheduleUpdate{
+		EffectiveTime:           params.StartTime.AddDate(4, 0, 1),
+		InitialAnnualProvisions: sdk.NewDec(600),
+		ReductionFactor:         sdk.NewDecWithPrec(9, 1),
+	})
+	schedule, err = queryClient.Schedule(gocontext.Background(), &types.QueryScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), schedule.Schedule[1].Year)
+	suite.Require().Equal(sdk.NewDec(125), schedule.Schedule[1].AnnualProvisions)
+	suite.Require().Equal(uint64(4), schedule.Schedule[2].Year)
+	suite.Require().Equal(sdk.NewDec(600), schedule.Schedule[2].AnnualProvisions)
+	suite.Require().Equal(sdk.NewDec(540), schedule.Schedule[3].AnnualProvisions)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/public-awesome/stargaze/x/mint/types"
 )
@@ -14,15 +15,19 @@ type Keeper struct {
 	cdc              codec.BinaryCodec
 	storeKey         sdk.StoreKey
 	paramSpace       paramtypes.Subspace
+	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	stakingKeeper    types.StakingKeeper
+	distrKeeper      types.DistrKeeper
+	allocKeeper      types.AllocKeeper
 	feeCollectorName string
 }
 
 // NewKeeper creates a new mint Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string,
+	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
+	dk types.DistrKeeper, alk types.AllocKeeper, feeCollectorName string,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -38,7 +43,11 @@ func NewKeeper(
 		cdc:              cdc,
 		storeKey:         key,
 		paramSpace:       paramSpace,
+		accountKeeper:    ak,
 		bankKeeper:       bk,
+		stakingKeeper:    sk,
+		distrKeeper:      dk,
+		allocKeeper:      alk,
 		feeCollectorName: feeCollectorName,
 	}
 }
@@ -67,17 +76,75 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, b)
 }
 
+// GetPendingScheduleUpdate returns the schedule update waiting for its effective time
+func (k Keeper) GetPendingScheduleUpdate(ctx sdk.Context) (update types.ScheduleUpdate, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PendingScheduleUpdateKey)
+	if b == nil {
+		return update, false
+	}
+
+	k.cdc.MustUnmarshal(b, &update)
+	return update, true
+}
+
+// SetPendingScheduleUpdate sets the schedule update waiting for its effective time
+func (k Keeper) SetPendingScheduleUpdate(ctx sdk.Context, update types.ScheduleUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&update)
+	store.Set(types.PendingScheduleUpdateKey, b)
+}
+
+// DeletePendingScheduleUpdate removes the schedule update waiting for its effective time
+func (k Keeper) DeletePendingScheduleUpdate(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingScheduleUpdateKey)
+}
+
 // GetParams returns the total set of minting parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return params
 }
 
-// SetParams sets the total set of minting parameters.
+// SetParams sets the total set of minting parameters. It panics on mint destinations
+// which can't receive coins.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	if err := k.ValidateMintDestinations(params.MintDestinations); err != nil {
+		panic(err)
+	}
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// ValidateParams validates the stored minting parameters against the chain state,
+// which param change proposals don't do.
+func (k Keeper) ValidateParams(ctx sdk.Context) error {
+	return k.ValidateMintDestinations(k.GetParams(ctx).MintDestinations)
+}
+
+// ValidateMintDestinations checks the mint destinations can receive coins: module
+// destinations need an existing module account and addresses must not be blocked by
+// the bank module.
+func (k Keeper) ValidateMintDestinations(destinations []types.WeightedMintDestination) error {
+	for _, d := range destinations {
+		if d.Module != "" {
+			if k.accountKeeper.GetModuleAddress(d.Module) == nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "mint destination module account %s does not exist", d.Module)
+			}
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(d.Address)
+		if err != nil {
+			return err
+		}
+		if k.bankKeeper.BlockedAddr(addr) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "mint destination %s is not allowed to receive funds", d.Address)
+		}
+	}
+
+	return nil
+}
+
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in BeginBlocker.
 func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
@@ -94,3 +161,155 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// DistributeMintedCoins sends the minted coins to the mint destinations split by weight,
+// the last destination receives the rounding remainder. Everything is sent to the fee
+// collector when no destinations are set.
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, mintedCoins sdk.Coins) error {
+	destinations := k.GetParams(ctx).MintDestinations
+	if len(destinations) == 0 {
+		destinations = []types.WeightedMintDestination{{Module: k.feeCollectorName, Weight: sdk.OneDec()}}
+	}
+
+	remaining := mintedCoins
+	for i, d := range destinations {
+		portion := remaining
+		if i < len(destinations)-1 {
+			portion = sdk.NewCoins()
+			for _, coin := range mintedCoins {
+				portion = portion.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(d.Weight).TruncateInt()))
+			}
+		}
+		remaining = remaining.Sub(portion)
+		if portion.IsZero() {
+			continue
+		}
+
+		var recipient sdk.AccAddress
+		if d.Module != "" {
+			recipient = k.accountKeeper.GetModuleAddress(d.Module)
+			if recipient == nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "mint destination module account %s does not exist", d.Module)
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, d.Module, portion); err != nil {
+				return err
+			}
+		} else {
+			var err error
+			recipient, err = sdk.AccAddressFromBech32(d.Address)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, portion); err != nil {
+				return err
+			}
+		}
+
+		err := ctx.EventManager().EmitTypedEvent(&types.EventMintDestination{
+			Module:  d.Module,
+			Address: recipient.String(),
+			Amount:  portion,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetRemainingSupply returns the amount which can still be minted before the supply
+// high-water mark reaches the max supply, and false if minting isn't capped by a max supply.
+func (k Keeper) GetRemainingSupply(ctx sdk.Context) (sdk.Int, bool) {
+	params := k.GetParams(ctx)
+	if !params.HasMaxSupply() {
+		return sdk.ZeroInt(), false
+	}
+
+	peak := k.GetPeakSupply(ctx)
+	if peak.GTE(params.MaxSupply) {
+		return sdk.ZeroInt(), true
+	}
+
+	return params.MaxSupply.Sub(peak), true
+}
+
+// ValidateExternalMint returns an error if minting the coins outside of the mint module
+// would take the supply high-water mark of the mint denom above the max supply.
+func (k Keeper) ValidateExternalMint(ctx sdk.Context, coins sdk.Coins) error {
+	amount := coins.AmountOf(k.GetParams(ctx).MintDenom)
+	if !amount.IsPositive() {
+		return nil
+	}
+	if remaining, capped := k.GetRemainingSupply(ctx); capped && amount.GT(remaining) {
+		return sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "cannot mint %s, only %s left before the max supply", amount, remaining)
+	}
+
+	return nil
+}
+
+// GetPeakSupply returns the high-water mark of the mint denom supply. It isn't lowered
+// by burns, so burned coins don't make room for new mints under the max supply.
+func (k Keeper) GetPeakSupply(ctx sdk.Context) sdk.Int {
+	params := k.GetParams(ctx)
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	peak := k.GetMinter(ctx).PeakSupply
+	if peak.IsNil() || supply.GT(peak) {
+		return supply
+	}
+
+	return peak
+}
+
+// GetInflation returns the current annual provisions relative to the current supply. The
+// provisions are clamped to the remaining supply, so inflation drops to zero once the max
+// supply is reached.
+func (k Keeper) GetInflation(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	if !supply.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	provisions := k.GetMinter(ctx).AnnualProvisions
+	if remaining, capped := k.GetRemainingSupply(ctx); capped && provisions.GT(remaining.ToDec()) {
+		provisions = remaining.ToDec()
+	}
+
+	return provisions.QuoInt(supply)
+}
+
+// GetStakingAPR returns the current annual percentage rate earned by stakers. Only the
+// minted coins sent to the fee collector reach the stakers.
+func (k Keeper) GetStakingAPR(ctx sdk.Context) sdk.Dec {
+	bondedRatio := k.stakingKeeper.BondedRatio(ctx)
+	if !bondedRatio.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	proportions := k.allocKeeper.GetParams(ctx).DistributionProportions
+	stakingProportion := sdk.OneDec().Sub(proportions.NftIncentives).Sub(proportions.DeveloperRewards)
+	communityTax := k.distrKeeper.GetCommunityTax(ctx)
+
+	return k.GetInflation(ctx).
+		Mul(k.feeCollectorWeight(ctx)).
+		Mul(stakingProportion).
+		Mul(sdk.OneDec().Sub(communityTax)).
+		Quo(bondedRatio)
+}
+
+// feeCollectorWeight returns the share of the minted coins sent to the fee collector.
+func (k Keeper) feeCollectorWeight(ctx sdk.Context) sdk.Dec {
+	destinations := k.GetParams(ctx).MintDestinations
+	if len(destinations) == 0 {
+		return sdk.OneDec()
+	}
+
+	weight := sdk.ZeroDec()
+	for _, d := range destinations {
+		if d.Module == k.feeCollectorName {
+			weight = weight.Add(d.Weight)
+		}
+	}
+	return weight
+}
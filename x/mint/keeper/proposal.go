@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/public-awesome/stargaze/x/mint/types"
+)
+
+// HandleScheduleUpdateProposal stores the schedule update until its effective time. A pending
+// update which didn't take effect yet is replaced.
+func HandleScheduleUpdateProposal(ctx sdk.Context, k Keeper, p *types.ScheduleUpdateProposal) error {
+	if !p.EffectiveTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "effective time %s must be after the block time %s", p.EffectiveTime, ctx.BlockTime())
+	}
+	update := p.ScheduleUpdate()
+	k.SetPendingScheduleUpdate(ctx, update)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleUpdate,
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, update.EffectiveTime.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyInitialAnnualProvisions, update.InitialAnnualProvisions.String()),
+			sdk.NewAttribute(types.AttributeKeyReductionFactor, update.ReductionFactor.String()),
+		),
+	)
+	return nil
+}
+
+// ApplyPendingScheduleUpdate applies the pending schedule update once its effective time is reached.
+// The start time is kept so the year count continues, the new initial annual provisions apply to the
+// current year and are reduced each following year.
+func (k Keeper) ApplyPendingScheduleUpdate(ctx sdk.Context) {
+	update, found := k.GetPendingScheduleUpdate(ctx)
+	if !found || ctx.BlockTime().Before(update.EffectiveTime) {
+		return
+	}
+
+	minter, params := update.Apply(ctx.BlockTime(), k.GetMinter(ctx), k.GetParams(ctx))
+	k.SetParams(ctx, params)
+	k.SetMinter(ctx, minter)
+
+	k.DeletePendingScheduleUpdate(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleUpdateApplied,
+			sdk.NewAttribute(types.AttributeKeyInitialAnnualProvisions, update.InitialAnnualProvisions.String()),
+			sdk.NewAttribute(types.AttributeKeyReductionFactor, update.ReductionFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyScheduleStartYear, fmt.Sprintf("%d", minter.ScheduleStartYear)),
+		),
+	)
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/public-awesome/stargaze/x/mint"
+	"github.com/public-awesome/stargaze/x/mint/keeper"
+	"github.com/public-awesome/stargaze/x/mint/types"
+)
+
+func (suite *MintTestSuite) TestScheduleUpdateProposal() {
+	app := suite.app
+
+	params := app.MintKeeper.GetParams(suite.ctx)
+	params.InitialAnnualProvisions = sdk.NewDec(1000)
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+	app.MintKeeper.SetParams(suite.ctx, params)
+
+	// in the third year of the schedule
+	ctx := suite.ctx.WithBlockTime(params.StartTime.AddDate(2, 0, 1))
+	minter := app.MintKeeper.GetMinter(ctx)
+	suite.Require().Equal(sdk.NewDec(250), minter.NextAnnualProvisions(ctx.BlockTime(), params))
+
+	// the effective time must be in the future
+	proposal := types.NewScheduleUpdateProposal("title", "description", ctx.BlockTime(), sdk.NewDec(600), sdk.NewDecWithPrec(9, 1))
+	err := keeper.HandleScheduleUpdateProposal(ctx, app.MintKeeper, proposal)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidProposalContent)
+	_, found := app.MintKeeper.GetPendingScheduleUpdate(ctx)
+	suite.Require().False(found)
+
+	effectiveTime := ctx.BlockTime().Add(time.Hour)
+	proposal.EffectiveTime = effectiveTime
+	err = keeper.HandleScheduleUpdateProposal(ctx, app.MintKeeper, proposal)
+	suite.Require().NoError(err)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.MintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+	res, err := queryClient.PendingScheduleUpdate(gocontext.Background(), &types.QueryPendingScheduleUpdateRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposal.ScheduleUpdate(), res.ScheduleUpdate)
+
+	// not effective yet
+	app.MintKeeper.ApplyPendingScheduleUpdate(ctx)
+	_, found = app.MintKeeper.GetPendingScheduleUpdate(ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(params, app.MintKeeper.GetParams(ctx))
+
+	ctx = ctx.WithBlockTime(effectiveTime)
+	app.MintKeeper.ApplyPendingScheduleUpdate(ctx)
+	_, found = app.MintKeeper.GetPendingScheduleUpdate(ctx)
+	suite.Require().False(found)
+
+	// the start time is kept and the new initial annual provisions apply to the current year
+	params = app.MintKeeper.GetParams(ctx)
+	minter = app.MintKeeper.GetMinter(ctx)
+	suite.Require().Equal(sdk.NewDec(600), params.InitialAnnualProvisions)
+	suite.Require().Equal(sdk.NewDecWithPrec(9, 1), params.ReductionFactor)
+	suite.Require().Equal(uint64(2), minter.ScheduleStartYear)
+	suite.Require().Equal(sdk.NewDec(600), minter.NextAnnualProvisions(ctx.BlockTime(), params))
+	suite.Require().Equal(sdk.NewDec(540), minter.NextAnnualProvisions(params.StartTime.AddDate(3, 0, 1), params))
+
+	queryHelper = baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.MintKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+	_, err = queryClient.PendingScheduleUpdate(gocontext.Background(), &types.QueryPendingScheduleUpdateRequest{})
+	suite.Require().Error(err)
+}
+
+func (suite *MintTestSuite) TestParamChangeProposalMintDestinations() {
+	app := suite.app
+	handler := mint.NewParamChangeProposalHandler(app.MintKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+	distribution := authtypes.NewModuleAddress(distrtypes.ModuleName)
+
+	for _, tc := range []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"module account", `[{"module":"distribution","weight":"1.000000000000000000"}]`, true},
+		{"unknown module", `[{"module":"unknown","weight":"1.000000000000000000"}]`, false},
+		{"blocked address", `[{"address":"` + distribution.String() + `","weight":"1.000000000000000000"}]`, false},
+	} {
+		ctx, _ := suite.ctx.CacheContext()
+		change := paramproposal.NewParamChange(types.ModuleName, string(types.KeyMintDestinations), tc.value)
+		err := handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{change}))
+		if tc.valid {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().ErrorIs(err, govtypes.ErrInvalidProposalContent, tc.name)
+		}
+	}
+
+	// the keeper refuses to set such destinations directly
+	mintParams := app.MintKeeper.GetParams(suite.ctx)
+	mintParams.MintDestinations = []types.WeightedMintDestination{{Address: distribution.String(), Weight: sdk.OneDec()}}
+	suite.Require().Panics(func() { app.MintKeeper.SetParams(suite.ctx, mintParams) })
+}
@@ -0,0 +1,12 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/mint module sentinel errors
+var (
+	ErrMaxSupplyExceeded = sdkerrors.Register(ModuleName, 2, "max supply exceeded")
+)
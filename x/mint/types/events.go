@@ -2,7 +2,13 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint                  = ModuleName
+	EventTypeScheduleUpdate        = "schedule_update"
+	EventTypeScheduleUpdateApplied = "schedule_update_applied"
 
-	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyAnnualProvisions        = "annual_provisions"
+	AttributeKeyEffectiveTime           = "effective_time"
+	AttributeKeyInitialAnnualProvisions = "initial_annual_provisions"
+	AttributeKeyReductionFactor         = "reduction_factor"
+	AttributeKeyScheduleStartYear       = "schedule_start_year"
 )
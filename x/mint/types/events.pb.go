@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stargaze/mint/v1beta1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventMintDestination is emitted for each destination the minted coins of a
+// block are sent to
+type EventMintDestination struct {
+	// name of the receiving module account, empty for an address destination
+	Module  string                                   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty" yaml:"module"`
+	Address string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *EventMintDestination) Reset()         { *m = EventMintDestination{} }
+func (m *EventMintDestination) String() string { return proto.CompactTextString(m) }
+func (*EventMintDestination) ProtoMessage()    {}
+func (*EventMintDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_680c608445e80fb2, []int{0}
+}
+func (m *EventMintDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMintDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMintDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMintDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMintDestination.Merge(m, src)
+}
+func (m *EventMintDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMintDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMintDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMintDestination proto.InternalMessageInfo
+
+func (m *EventMintDestination) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *EventMintDestination) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventMintDestination) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventMintDestination)(nil), "stargaze.mint.v1beta1.EventMintDestination")
+}
+
+func init() {
+	proto.RegisterFile("stargaze/mint/v1beta1/events.proto", fileDescriptor_680c608445e80fb2)
+}
+
+var fileDescriptor_680c608445e80fb2 = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x3f, 0x4e, 0xf3, 0x30,
+	0x14, 0xc0, 0x93, 0xaf, 0x52, 0x3f, 0x11, 0x04, 0x12, 0x51, 0x91, 0x4a, 0x07, 0xa7, 0xf2, 0x54,
+	0x24, 0x6a, 0xab, 0xb0, 0xb1, 0x51, 0x60, 0x60, 0x60, 0xe9, 0xc8, 0xe6, 0x24, 0x56, 0xb0, 0xa8,
+	0xfd, 0xaa, 0xfa, 0xa5, 0x50, 0x4e, 0xc1, 0x39, 0x38, 0x49, 0xc7, 0x8e, 0x4c, 0x05, 0xb5, 0xe2,
+	0x02, 0x3d, 0x01, 0x4a, 0x9c, 0x14, 0x26, 0x5b, 0x7e, 0x3f, 0xff, 0xde, 0xbf, 0x80, 0x5a, 0x14,
+	0xd3, 0x4c, 0xbc, 0x4a, 0xae, 0x95, 0x41, 0x3e, 0x1b, 0xc4, 0x12, 0xc5, 0x80, 0xcb, 0x99, 0x34,
+	0x68, 0xd9, 0x64, 0x0a, 0x08, 0xe1, 0x71, 0xcd, 0xb0, 0x82, 0x61, 0x15, 0xd3, 0x69, 0x65, 0x90,
+	0x41, 0x49, 0xf0, 0xe2, 0xe6, 0xe0, 0x0e, 0x49, 0xc0, 0x6a, 0xb0, 0x3c, 0x16, 0x56, 0xee, 0x74,
+	0x09, 0x28, 0xe3, 0xe2, 0xf4, 0xdb, 0x0f, 0x5a, 0xb7, 0x85, 0xfd, 0x5e, 0x19, 0xbc, 0x91, 0x16,
+	0x95, 0x11, 0xa8, 0xc0, 0x84, 0xa7, 0x41, 0x53, 0x43, 0x9a, 0x8f, 0x65, 0xdb, 0xef, 0xfa, 0xbd,
+	0xbd, 0xe1, 0xd1, 0x76, 0x15, 0x1d, 0xcc, 0x85, 0x1e, 0x5f, 0x52, 0xf7, 0x4e, 0x47, 0x15, 0x10,
+	0x9e, 0x05, 0xff, 0x45, 0x9a, 0x4e, 0xa5, 0xb5, 0xed, 0x7f, 0x25, 0x1b, 0x6e, 0x57, 0xd1, 0xa1,
+	0x63, 0xab, 0x00, 0x1d, 0xd5, 0x48, 0x88, 0x41, 0x53, 0x68, 0xc8, 0x0d, 0xb6, 0x1b, 0xdd, 0x46,
+	0x6f, 0xff, 0xfc, 0x84, 0xb9, 0x12, 0x59, 0x51, 0x62, 0xdd, 0x0d, 0xbb, 0x06, 0x65, 0x86, 0x57,
+	0x8b, 0x55, 0xe4, 0xfd, 0xe6, 0x75, 0xdf, 0xe8, 0xfb, 0x67, 0xd4, 0xcb, 0x14, 0x3e, 0xe6, 0x31,
+	0x4b, 0x40, 0xf3, 0xaa, 0x41, 0x77, 0xf4, 0x6d, 0xfa, 0xc4, 0x71, 0x3e, 0x91, 0xb6, 0x34, 0xd8,
+	0x51, 0x95, 0x6b, 0x78, 0xb7, 0x58, 0x13, 0x7f, 0xb9, 0x26, 0xfe, 0xd7, 0x9a, 0xf8, 0x6f, 0x1b,
+	0xe2, 0x2d, 0x37, 0xc4, 0xfb, 0xd8, 0x10, 0xef, 0x81, 0xff, 0x71, 0x4d, 0xf2, 0x78, 0xac, 0x92,
+	0xbe, 0x78, 0x96, 0x16, 0xb4, 0xe4, 0xbb, 0x65, 0xbc, 0xb8, 0x75, 0x94, 0xe2, 0xb8, 0x59, 0x4e,
+	0xee, 0xe2, 0x67, 0x00, 0xb0, 0x2f, 0xae, 0xa5, 0xac, 0x01, 0x00, 0x00,
+}
+
+func (m *EventMintDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMintDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMintDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventMintDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventMintDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMintDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMintDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -3,6 +3,7 @@ package types // noalias
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	alloctypes "github.com/public-awesome/stargaze/x/alloc/types"
 )
 
 // AccountKeeper defines the contract required for account APIs.
@@ -20,4 +21,24 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// StakingKeeper defines the expected staking keeper used to compute the
+// staking APR.
+type StakingKeeper interface {
+	BondedRatio(ctx sdk.Context) sdk.Dec
+}
+
+// DistrKeeper defines the expected distribution keeper used to compute the
+// staking APR.
+type DistrKeeper interface {
+	GetCommunityTax(ctx sdk.Context) sdk.Dec
+}
+
+// AllocKeeper defines the expected alloc keeper used to compute the
+// staking APR.
+type AllocKeeper interface {
+	GetParams(ctx sdk.Context) alloctypes.Params
 }
@@ -23,5 +23,11 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if data.PendingScheduleUpdate != nil {
+		if err := data.PendingScheduleUpdate.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return ValidateMinter(data.Minter)
 }
@@ -29,6 +29,9 @@ type GenesisState struct {
 	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// pending_schedule_update is the schedule update waiting for its effective
+	// time, if any.
+	PendingScheduleUpdate *ScheduleUpdate `protobuf:"bytes,3,opt,name=pending_schedule_update,json=pendingScheduleUpdate,proto3" json:"pending_schedule_update,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +81,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPendingScheduleUpdate() *ScheduleUpdate {
+	if m != nil {
+		return m.PendingScheduleUpdate
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stargaze.mint.v1beta1.GenesisState")
 }
@@ -87,22 +97,24 @@ func init() {
 }
 
 var fileDescriptor_97324df1b14fbd08 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
+	// 272 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x2e, 0x49, 0x2c,
 	0x4a, 0x4f, 0xac, 0x4a, 0xd5, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
 	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
 	0x12, 0x85, 0x29, 0xd2, 0x03, 0x29, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x14, 0xb0, 0x9b, 0x08, 0xd6, 0x09, 0x56, 0xa1, 0xd4,
-	0xc1, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x20, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x9a, 0x8b, 0x0d,
-	0x24, 0x9d, 0x5a, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xab, 0x87, 0xd5, 0x42, 0x3d,
-	0x5f, 0xb0, 0x22, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x5a, 0x40, 0x9a, 0x0b, 0x12,
-	0x8b, 0x12, 0x73, 0x8b, 0x25, 0x98, 0xf0, 0x6a, 0x0e, 0x00, 0x2b, 0x82, 0x69, 0x86, 0x68, 0x71,
-	0xf2, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xfd, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x82, 0xd2, 0xa4, 0x9c, 0xcc, 0x64, 0xdd, 0xc4,
-	0xf2, 0xd4, 0xe2, 0xfc, 0xdc, 0x54, 0x7d, 0xb8, 0x07, 0x2b, 0x20, 0x5e, 0x2c, 0xa9, 0x2c, 0x48,
-	0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xce, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xef, 0x36, 0xa4, 0x39,
-	0x52, 0x01, 0x00, 0x00,
+	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x14, 0xb0, 0x9b, 0x08, 0xd6, 0x09, 0x56, 0xa1, 0xf4,
+	0x9c, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x41, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x35, 0x17, 0x1b,
+	0x48, 0x3a, 0xb5, 0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x56, 0x0f, 0xab, 0x85, 0x7a,
+	0xbe, 0x60, 0x45, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xb5, 0x80, 0x34, 0x17, 0x24,
+	0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0xe1, 0xd5, 0x1c, 0x00, 0x56, 0x04, 0xd3, 0x0c, 0xd1, 0x22,
+	0x14, 0xcb, 0x25, 0x5e, 0x90, 0x9a, 0x97, 0x92, 0x99, 0x97, 0x1e, 0x5f, 0x9c, 0x9c, 0x91, 0x9a,
+	0x52, 0x9a, 0x93, 0x1a, 0x5f, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x2a, 0xc1, 0x0c, 0x36, 0x4d, 0x15,
+	0x87, 0x69, 0xc1, 0x50, 0xd5, 0xa1, 0x60, 0xc5, 0x41, 0xa2, 0x50, 0x53, 0x50, 0x85, 0x9d, 0x3c,
+	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
+	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3,
+	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0xa0, 0x34, 0x29, 0x27, 0x33, 0x59, 0x37, 0xb1, 0x3c,
+	0xb5, 0x38, 0x3f, 0x37, 0x55, 0x1f, 0x1e, 0x7e, 0x15, 0x90, 0x10, 0x2c, 0xa9, 0x2c, 0x48, 0x2d,
+	0x4e, 0x62, 0x03, 0x87, 0x9d, 0x31, 0x60, 0x00, 0x13, 0xec, 0xc2, 0x75, 0xb1, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +137,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingScheduleUpdate != nil {
+		{
+			size, err := m.PendingScheduleUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -169,6 +193,10 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.PendingScheduleUpdate != nil {
+		l = m.PendingScheduleUpdate.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -273,6 +301,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingScheduleUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingScheduleUpdate == nil {
+				m.PendingScheduleUpdate = &ScheduleUpdate{}
+			}
+			if err := m.PendingScheduleUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,12 @@
 package types
 
-// MinterKey is the key to use for the keeper store.
-var MinterKey = []byte{0x00}
+var (
+	// MinterKey is the key to use for the keeper store.
+	MinterKey = []byte{0x00}
+
+	// PendingScheduleUpdateKey is the key of the schedule update waiting for its effective time.
+	PendingScheduleUpdateKey = []byte{0x01}
+)
 
 const (
 	// module name
@@ -13,6 +18,12 @@ const (
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 
+	// RouterKey is the routing key of the minting governance proposals.
+	RouterKey = ModuleName
+
+	// MaxScheduleYears is the maximum number of years projected by the schedule query
+	MaxScheduleYears = 100
+
 	// Query endpoints supported by the minting querier
 	QueryParameters       = "parameters"
 	QueryAnnualProvisions = "annual_provisions"
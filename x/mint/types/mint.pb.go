@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -28,10 +29,48 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProvisioningMode defines how the annual provisions are split between blocks
+type ProvisioningMode int32
+
+const (
+	// mint the annual provisions divided by the expected blocks per year
+	ProvisioningModeBlocksPerYear ProvisioningMode = 0
+	// mint the annual provisions for the time elapsed since the previous block
+	ProvisioningModeBlockTime ProvisioningMode = 1
+)
+
+var ProvisioningMode_name = map[int32]string{
+	0: "ProvisioningModeBlocksPerYear",
+	1: "ProvisioningModeBlockTime",
+}
+
+var ProvisioningMode_value = map[string]int32{
+	"ProvisioningModeBlocksPerYear": 0,
+	"ProvisioningModeBlockTime":     1,
+}
+
+func (x ProvisioningMode) String() string {
+	return proto.EnumName(ProvisioningMode_name, int32(x))
+}
+
+func (ProvisioningMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_736e1519c3888655, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual expected provisions
 	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions" yaml:"annual_provisions"`
+	// time of the previous block, used by the time based provisioning
+	PrevBlockTime time.Time `protobuf:"bytes,2,opt,name=prev_block_time,json=prevBlockTime,proto3,stdtime" json:"prev_block_time" yaml:"prev_block_time"`
+	// total amount minted since the start time
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_minted,json=totalMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_minted" yaml:"total_minted"`
+	// year since the start time from which the reduction factor is applied to
+	// the initial annual provisions, moved forward by schedule updates
+	ScheduleStartYear uint64 `protobuf:"varint,4,opt,name=schedule_start_year,json=scheduleStartYear,proto3" json:"schedule_start_year,omitempty" yaml:"schedule_start_year"`
+	// high-water mark of the mint denom supply, raised by every mint of the
+	// module. Burns don't lower it so they can't reopen the max supply cap.
+	PeakSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=peak_supply,json=peakSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"peak_supply" yaml:"peak_supply"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -67,6 +106,71 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
+func (m *Minter) GetPrevBlockTime() time.Time {
+	if m != nil {
+		return m.PrevBlockTime
+	}
+	return time.Time{}
+}
+
+func (m *Minter) GetScheduleStartYear() uint64 {
+	if m != nil {
+		return m.ScheduleStartYear
+	}
+	return 0
+}
+
+// ScheduleUpdate defines a change of the minting schedule taking effect at a
+// future time
+type ScheduleUpdate struct {
+	// time the new schedule takes effect at
+	EffectiveTime time.Time `protobuf:"bytes,1,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time" yaml:"effective_time"`
+	// annual provisions of the year the new schedule takes effect in
+	InitialAnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=initial_annual_provisions,json=initialAnnualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_annual_provisions" yaml:"initial_annual_provisions"`
+	// factor the annual provisions are reduced by each following year
+	ReductionFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reduction_factor,json=reductionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reduction_factor" yaml:"reduction_factor"`
+}
+
+func (m *ScheduleUpdate) Reset()         { *m = ScheduleUpdate{} }
+func (m *ScheduleUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduleUpdate) ProtoMessage()    {}
+func (*ScheduleUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_736e1519c3888655, []int{1}
+}
+func (m *ScheduleUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleUpdate.Merge(m, src)
+}
+func (m *ScheduleUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleUpdate proto.InternalMessageInfo
+
+func (m *ScheduleUpdate) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
@@ -79,12 +183,25 @@ type Params struct {
 	ReductionFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reduction_factor,json=reductionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reduction_factor" yaml:"reduction_factor"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,5,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// how the annual provisions are split between blocks
+	ProvisioningMode ProvisioningMode `protobuf:"varint,6,opt,name=provisioning_mode,json=provisioningMode,proto3,enum=stargaze.mint.v1beta1.ProvisioningMode" json:"provisioning_mode,omitempty" yaml:"provisioning_mode"`
+	// maximum time elapsed since the previous block minted for in a block, caps
+	// the provisions after a halt in the block time mode
+	MaxBlockDuration time.Duration `protobuf:"bytes,7,opt,name=max_block_duration,json=maxBlockDuration,proto3,stdduration" json:"max_block_duration,omitempty" yaml:"max_block_duration"`
+	// maximum total supply of the mint denom, minting stops once the supply
+	// high-water mark reaches it. Burned coins aren't minted again. Claim module
+	// mints are rejected above it as well. Zero disables the cap.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	// destinations the minted coins are split between by weight. Everything is
+	// sent to the fee collector when empty or when a destination can't receive
+	// the coins.
+	MintDestinations []WeightedMintDestination `protobuf:"bytes,9,rep,name=mint_destinations,json=mintDestinations,proto3" json:"mint_destinations" yaml:"mint_destinations"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_736e1519c3888655, []int{1}
+	return fileDescriptor_736e1519c3888655, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,44 +251,155 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetProvisioningMode() ProvisioningMode {
+	if m != nil {
+		return m.ProvisioningMode
+	}
+	return ProvisioningModeBlocksPerYear
+}
+
+func (m *Params) GetMaxBlockDuration() time.Duration {
+	if m != nil {
+		return m.MaxBlockDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMintDestinations() []WeightedMintDestination {
+	if m != nil {
+		return m.MintDestinations
+	}
+	return nil
+}
+
+// WeightedMintDestination defines a module account or an address receiving a
+// share of the minted coins
+type WeightedMintDestination struct {
+	// name of the receiving module account, exclusive with address
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty" yaml:"module"`
+	// receiving account address, exclusive with module
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// share of the minted coins
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedMintDestination) Reset()         { *m = WeightedMintDestination{} }
+func (m *WeightedMintDestination) String() string { return proto.CompactTextString(m) }
+func (*WeightedMintDestination) ProtoMessage()    {}
+func (*WeightedMintDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_736e1519c3888655, []int{3}
+}
+func (m *WeightedMintDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedMintDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedMintDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedMintDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedMintDestination.Merge(m, src)
+}
+func (m *WeightedMintDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedMintDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedMintDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedMintDestination proto.InternalMessageInfo
+
+func (m *WeightedMintDestination) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *WeightedMintDestination) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("stargaze.mint.v1beta1.ProvisioningMode", ProvisioningMode_name, ProvisioningMode_value)
 	proto.RegisterType((*Minter)(nil), "stargaze.mint.v1beta1.Minter")
+	proto.RegisterType((*ScheduleUpdate)(nil), "stargaze.mint.v1beta1.ScheduleUpdate")
 	proto.RegisterType((*Params)(nil), "stargaze.mint.v1beta1.Params")
+	proto.RegisterType((*WeightedMintDestination)(nil), "stargaze.mint.v1beta1.WeightedMintDestination")
 }
 
 func init() { proto.RegisterFile("stargaze/mint/v1beta1/mint.proto", fileDescriptor_736e1519c3888655) }
 
 var fileDescriptor_736e1519c3888655 = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x63, 0x56, 0x2a, 0xd5, 0x08, 0x6d, 0x8b, 0x80, 0x85, 0x4a, 0x4b, 0xa2, 0x1c, 0x50,
-	0x2f, 0x8b, 0x35, 0xb8, 0xed, 0x46, 0x34, 0x21, 0x0d, 0x09, 0xa9, 0x8a, 0x38, 0x00, 0x97, 0xc8,
-	0x49, 0xbd, 0x60, 0x2d, 0x8e, 0x23, 0xdb, 0xd9, 0x28, 0x37, 0x5e, 0x00, 0xed, 0xc8, 0x91, 0xc7,
-	0xd9, 0x71, 0xe2, 0x84, 0x38, 0x04, 0xd4, 0xbe, 0x41, 0x9f, 0x00, 0xd9, 0x6e, 0x37, 0xd4, 0x89,
-	0x43, 0x4f, 0x89, 0x7f, 0xf9, 0xf2, 0xf7, 0xf7, 0x7d, 0x36, 0x0c, 0xa5, 0xc2, 0xa2, 0xc4, 0x9f,
-	0x09, 0x62, 0xb4, 0x56, 0xe8, 0xfc, 0x30, 0x27, 0x0a, 0x1f, 0x9a, 0x45, 0xdc, 0x08, 0xae, 0xb8,
-	0xfb, 0x78, 0xa5, 0x88, 0x0d, 0x5c, 0x2a, 0x86, 0x8f, 0x4a, 0x5e, 0x72, 0xa3, 0x40, 0xfa, 0xcd,
-	0x8a, 0x87, 0x41, 0xc9, 0x79, 0x59, 0x11, 0x64, 0x56, 0x79, 0x7b, 0x8a, 0x14, 0x65, 0x44, 0x2a,
-	0xcc, 0x1a, 0x2b, 0x88, 0xbe, 0x00, 0xd8, 0x7f, 0x43, 0x6b, 0x45, 0x84, 0x7b, 0x01, 0x77, 0x71,
-	0x5d, 0xb7, 0xb8, 0xca, 0x1a, 0xc1, 0xcf, 0xa9, 0xa4, 0xbc, 0x96, 0x1e, 0x08, 0xc1, 0x68, 0x90,
-	0xbc, 0xbe, 0xea, 0x02, 0xe7, 0x57, 0x17, 0x3c, 0x2b, 0xa9, 0xfa, 0xd8, 0xe6, 0x71, 0xc1, 0x19,
-	0x2a, 0xb8, 0x64, 0x5c, 0x2e, 0x1f, 0x07, 0x72, 0x72, 0x86, 0xd4, 0xb4, 0x21, 0x32, 0x3e, 0x26,
-	0xc5, 0xa2, 0x0b, 0xbc, 0x29, 0x66, 0xd5, 0x51, 0x74, 0x67, 0x60, 0x94, 0xee, 0x58, 0x36, 0xbe,
-	0x45, 0x3f, 0xb6, 0x60, 0x7f, 0x8c, 0x05, 0x66, 0xd2, 0xdd, 0x87, 0x50, 0xa7, 0xca, 0x26, 0xa4,
-	0xe6, 0xcc, 0x6e, 0x9e, 0x0e, 0x34, 0x39, 0xd6, 0xc0, 0x7d, 0x07, 0xa1, 0x4e, 0xaf, 0x32, 0x1d,
-	0xc3, 0xbb, 0x17, 0x82, 0xd1, 0x83, 0xe7, 0xc3, 0xd8, 0x66, 0x8c, 0x57, 0x19, 0xe3, 0xb7, 0xab,
-	0x8c, 0xc9, 0xbe, 0xf6, 0xbd, 0xe8, 0x82, 0x5d, 0xeb, 0xe6, 0xf6, 0xdf, 0xe8, 0xf2, 0x77, 0x00,
-	0xd2, 0x81, 0x01, 0x5a, 0xee, 0x7e, 0x05, 0xf0, 0x29, 0xad, 0xa9, 0xa2, 0xb8, 0xca, 0xee, 0xb6,
-	0xb0, 0x65, 0x5a, 0x48, 0x37, 0x6e, 0x21, 0xb4, 0xfb, 0xfe, 0x77, 0x70, 0x94, 0xee, 0x2d, 0xbf,
-	0xbd, 0x5c, 0x2b, 0xc5, 0x55, 0x70, 0x47, 0x90, 0x49, 0x5b, 0x28, 0xca, 0xeb, 0xec, 0x14, 0x17,
-	0x8a, 0x0b, 0xaf, 0x67, 0x6c, 0x9c, 0x6c, 0x6c, 0x63, 0xcf, 0xda, 0x58, 0x9f, 0x17, 0xa5, 0xdb,
-	0x37, 0xe8, 0x95, 0x21, 0x6e, 0x02, 0xb7, 0xf3, 0x8a, 0x17, 0x67, 0x32, 0x6b, 0x88, 0xc8, 0xa6,
-	0x04, 0x0b, 0xef, 0x7e, 0x08, 0x46, 0xbd, 0x64, 0xb8, 0xe8, 0x82, 0x27, 0x76, 0xcc, 0x9a, 0x20,
-	0x4a, 0x1f, 0x5a, 0x32, 0x26, 0xe2, 0x3d, 0xc1, 0xe2, 0xa8, 0xf7, 0xed, 0x7b, 0xe0, 0x24, 0x27,
-	0x57, 0x33, 0x1f, 0x5c, 0xcf, 0x7c, 0xf0, 0x67, 0xe6, 0x83, 0xcb, 0xb9, 0xef, 0x5c, 0xcf, 0x7d,
-	0xe7, 0xe7, 0xdc, 0x77, 0x3e, 0xa0, 0x7f, 0x7c, 0x37, 0x6d, 0x5e, 0xd1, 0xe2, 0x00, 0x5f, 0x10,
-	0xc9, 0x19, 0x41, 0x37, 0x97, 0xff, 0x93, 0xbd, 0xfe, 0x26, 0x44, 0xde, 0x37, 0x27, 0xfb, 0xe2,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xe4, 0xba, 0x36, 0x1c, 0x03, 0x00, 0x00,
+	// 926 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0xae, 0x83, 0x27, 0x24, 0xb1, 0xa7, 0x94, 0x38, 0x56, 0xb3, 0xeb, 0xce, 0x01,
+	0x0c, 0x6a, 0x77, 0xd5, 0x70, 0xeb, 0x05, 0x61, 0x02, 0x52, 0x90, 0x82, 0xa2, 0x0d, 0xa8, 0xd0,
+	0xcb, 0x6a, 0xbc, 0x3b, 0x76, 0x56, 0xd9, 0xd9, 0x59, 0xed, 0x8c, 0x93, 0x18, 0x89, 0x3b, 0xe2,
+	0x80, 0x7a, 0xec, 0x11, 0x89, 0xff, 0x82, 0xbf, 0xa0, 0x12, 0x97, 0x1e, 0x11, 0x87, 0x05, 0x25,
+	0x37, 0x8e, 0xbe, 0x23, 0xa1, 0xf9, 0xb1, 0x4e, 0x6a, 0x27, 0x22, 0x3e, 0x20, 0xf5, 0x94, 0xcc,
+	0x37, 0xdf, 0xbc, 0xf7, 0x66, 0xde, 0xf7, 0x3e, 0x2f, 0xe8, 0x70, 0x81, 0xf3, 0x21, 0xfe, 0x8e,
+	0x78, 0x34, 0x4e, 0x85, 0x77, 0xf2, 0xb8, 0x4f, 0x04, 0x7e, 0xac, 0x16, 0x6e, 0x96, 0x33, 0xc1,
+	0xe0, 0xbd, 0x92, 0xe1, 0x2a, 0xd0, 0x30, 0xda, 0xef, 0x0c, 0xd9, 0x90, 0x29, 0x86, 0x27, 0xff,
+	0xd3, 0xe4, 0xb6, 0x33, 0x64, 0x6c, 0x98, 0x10, 0x4f, 0xad, 0xfa, 0xa3, 0x81, 0x27, 0x62, 0x4a,
+	0xb8, 0xc0, 0x34, 0x33, 0x04, 0x7b, 0x96, 0x10, 0x8d, 0x72, 0x2c, 0x62, 0x96, 0xea, 0x7d, 0xf4,
+	0x63, 0x15, 0xd4, 0xf6, 0xe3, 0x54, 0x90, 0x1c, 0x9e, 0x82, 0x26, 0x4e, 0xd3, 0x11, 0x4e, 0x82,
+	0x2c, 0x67, 0x27, 0x31, 0x8f, 0x59, 0xca, 0x5b, 0x56, 0xc7, 0xea, 0xd6, 0x7b, 0x5f, 0xbc, 0x2c,
+	0x9c, 0xca, 0x1f, 0x85, 0xf3, 0xde, 0x30, 0x16, 0x47, 0xa3, 0xbe, 0x1b, 0x32, 0xea, 0x85, 0x8c,
+	0x53, 0xc6, 0xcd, 0x9f, 0x47, 0x3c, 0x3a, 0xf6, 0xc4, 0x38, 0x23, 0xdc, 0xdd, 0x25, 0xe1, 0xa4,
+	0x70, 0x5a, 0x63, 0x4c, 0x93, 0x27, 0x68, 0x2e, 0x20, 0xf2, 0x1b, 0x1a, 0x3b, 0x98, 0x42, 0x70,
+	0x00, 0x36, 0xb2, 0x9c, 0x9c, 0x04, 0xfd, 0x84, 0x85, 0xc7, 0x81, 0xbc, 0x41, 0x6b, 0xa9, 0x63,
+	0x75, 0x57, 0x77, 0xda, 0xae, 0xae, 0xde, 0x2d, 0xab, 0x77, 0xbf, 0x2a, 0xaf, 0xd7, 0x43, 0xb2,
+	0xa4, 0x49, 0xe1, 0xbc, 0xab, 0x13, 0xcd, 0x04, 0x40, 0xcf, 0xff, 0x74, 0x2c, 0x7f, 0x4d, 0xa2,
+	0x3d, 0x09, 0xca, 0x73, 0xf0, 0x08, 0xbc, 0x2d, 0x98, 0xc0, 0x49, 0x20, 0x1f, 0x96, 0x44, 0xad,
+	0x65, 0x75, 0xb7, 0xcf, 0x16, 0xb8, 0xdb, 0x5e, 0x2a, 0x26, 0x85, 0x73, 0x57, 0xa7, 0xbc, 0x1a,
+	0x0b, 0xf9, 0xab, 0x6a, 0xa9, 0x9e, 0x32, 0x82, 0x5f, 0x82, 0xbb, 0x3c, 0x3c, 0x22, 0xd1, 0x28,
+	0x21, 0x81, 0x6c, 0xa7, 0x08, 0xc6, 0x04, 0xe7, 0xad, 0x6a, 0xc7, 0xea, 0x56, 0x7b, 0xf6, 0xa4,
+	0x70, 0xda, 0x3a, 0xc4, 0x35, 0x24, 0xe4, 0x37, 0x4b, 0xf4, 0x50, 0x82, 0xdf, 0x12, 0x9c, 0x43,
+	0x02, 0x56, 0x33, 0x82, 0x8f, 0x03, 0x3e, 0xca, 0xb2, 0x64, 0xdc, 0xba, 0xa3, 0x0a, 0xdf, 0x5d,
+	0xb8, 0x70, 0x68, 0xde, 0xea, 0x32, 0x14, 0xf2, 0x81, 0x5c, 0x1d, 0xea, 0xc5, 0x3f, 0x4b, 0x60,
+	0xfd, 0xd0, 0x24, 0xff, 0x3a, 0x8b, 0xb0, 0x20, 0x30, 0x02, 0xeb, 0x64, 0x30, 0x20, 0xa1, 0x88,
+	0x4f, 0x88, 0x6e, 0x8d, 0xf5, 0x9f, 0xad, 0x79, 0x60, 0x5a, 0x73, 0x4f, 0xa7, 0x7b, 0xfd, 0xbc,
+	0xe9, 0xcc, 0x14, 0x54, 0x9d, 0xf9, 0xc9, 0x02, 0x5b, 0x71, 0x1a, 0x8b, 0x18, 0x27, 0xc1, 0xbc,
+	0x06, 0x97, 0xd4, 0x75, 0xfd, 0x85, 0x35, 0xd8, 0xd1, 0xf9, 0x6f, 0x0c, 0x8c, 0xfc, 0x4d, 0xb3,
+	0xf7, 0xc9, 0xac, 0x24, 0x05, 0x68, 0xe4, 0x24, 0x1a, 0x85, 0x72, 0x52, 0x82, 0x01, 0x0e, 0x05,
+	0xcb, 0x8d, 0x5c, 0xf6, 0x16, 0x2e, 0x63, 0x53, 0x97, 0x31, 0x1b, 0x0f, 0xf9, 0x1b, 0x53, 0xe8,
+	0x73, 0x8d, 0xfc, 0xba, 0x02, 0x6a, 0x07, 0x38, 0xc7, 0x94, 0xc3, 0x6d, 0x00, 0xa4, 0xb2, 0x82,
+	0x88, 0xa4, 0x8c, 0xea, 0x29, 0xf4, 0xeb, 0x12, 0xd9, 0x95, 0x00, 0xfc, 0x06, 0x00, 0x2d, 0x99,
+	0x5b, 0x4e, 0xcb, 0xb6, 0x69, 0x49, 0xd3, 0xe8, 0x6e, 0x7a, 0x56, 0xb7, 0xa3, 0xae, 0x80, 0x5b,
+	0xb4, 0x62, 0xf9, 0xcd, 0x68, 0x45, 0xf5, 0xff, 0x6e, 0x05, 0xec, 0x81, 0x0d, 0xe5, 0x26, 0x3c,
+	0xc8, 0x48, 0xae, 0xa7, 0xf7, 0x8e, 0x9a, 0xde, 0xf6, 0xa5, 0xe7, 0xcc, 0x10, 0x90, 0xbf, 0xa6,
+	0x91, 0x03, 0x92, 0xab, 0xa9, 0xcd, 0x41, 0x73, 0x7a, 0xc3, 0x38, 0x1d, 0x06, 0x94, 0x45, 0xa4,
+	0x55, 0xeb, 0x58, 0xdd, 0xf5, 0x9d, 0xf7, 0xdd, 0x6b, 0x5d, 0xde, 0x3d, 0xb8, 0xc2, 0xdf, 0x67,
+	0x11, 0xe9, 0xdd, 0xbf, 0xf4, 0xd2, 0xb9, 0x58, 0xc8, 0x6f, 0x64, 0x33, 0x7c, 0xd9, 0x3e, 0x48,
+	0xf1, 0x99, 0xb1, 0xc2, 0xd2, 0xec, 0x5b, 0x2b, 0x4a, 0x21, 0x5b, 0x73, 0x0a, 0xd9, 0x35, 0x04,
+	0xed, 0x82, 0x7f, 0x17, 0xce, 0xfd, 0xf9, 0xc3, 0x0f, 0x19, 0x8d, 0x05, 0xa1, 0x99, 0x18, 0x4f,
+	0x0a, 0x67, 0x4b, 0xd7, 0x32, 0xcf, 0x42, 0x2f, 0xa4, 0x90, 0x1a, 0x14, 0x9f, 0x29, 0xc3, 0x2d,
+	0x03, 0xc3, 0x3e, 0x00, 0x92, 0x6c, 0x9c, 0xeb, 0x2d, 0xd5, 0xb8, 0x4f, 0x17, 0x76, 0xae, 0xe6,
+	0x65, 0xda, 0xd2, 0xb8, 0xea, 0x14, 0x9f, 0x69, 0xdf, 0x82, 0xdf, 0x83, 0xa6, 0x19, 0x16, 0x2e,
+	0xe2, 0x54, 0xe5, 0xe5, 0xad, 0x7a, 0x67, 0xb9, 0xbb, 0xba, 0xe3, 0xde, 0xf0, 0xd0, 0x4f, 0x49,
+	0x3c, 0x3c, 0x12, 0x24, 0xda, 0x57, 0x23, 0x35, 0x3d, 0xd6, 0xeb, 0x98, 0x41, 0x31, 0x6f, 0x3e,
+	0x17, 0x16, 0xf9, 0x0d, 0xfa, 0xfa, 0x11, 0xfe, 0xa4, 0xfa, 0xe2, 0x67, 0xa7, 0x82, 0x7e, 0xb3,
+	0xc0, 0xe6, 0x0d, 0x51, 0xe1, 0x07, 0xa0, 0x46, 0x99, 0x74, 0x55, 0xf3, 0x7b, 0xda, 0x9c, 0x14,
+	0xce, 0x9a, 0xc9, 0xa0, 0x70, 0xe4, 0x1b, 0x02, 0x7c, 0x08, 0x56, 0x70, 0x14, 0xe5, 0x84, 0x97,
+	0xbe, 0x07, 0x27, 0x85, 0xb3, 0xae, 0xb9, 0x66, 0x03, 0xf9, 0x25, 0x05, 0x3e, 0x05, 0xb5, 0x53,
+	0x95, 0xd3, 0x4c, 0xe6, 0xc7, 0x0b, 0x8f, 0x84, 0x29, 0x43, 0x47, 0x41, 0xbe, 0x09, 0xf7, 0xe1,
+	0x33, 0xd0, 0x98, 0xd5, 0x22, 0x7c, 0x00, 0xb6, 0xe7, 0xf4, 0x79, 0x55, 0xf0, 0x8d, 0x0a, 0xdc,
+	0x06, 0x5b, 0xd7, 0x52, 0xa4, 0xb5, 0x34, 0xac, 0x76, 0xf5, 0x87, 0x5f, 0xec, 0x4a, 0x6f, 0xef,
+	0xe5, 0xb9, 0x6d, 0xbd, 0x3a, 0xb7, 0xad, 0xbf, 0xce, 0x6d, 0xeb, 0xf9, 0x85, 0x5d, 0x79, 0x75,
+	0x61, 0x57, 0x7e, 0xbf, 0xb0, 0x2b, 0xcf, 0xbc, 0x2b, 0x65, 0x67, 0xa3, 0x7e, 0x12, 0x87, 0x8f,
+	0xf0, 0x29, 0xe1, 0x8c, 0x12, 0x6f, 0xfa, 0xdd, 0x74, 0xa6, 0xbf, 0x9c, 0xd4, 0x1d, 0xfa, 0x35,
+	0xa5, 0xe4, 0x8f, 0xfe, 0x1d, 0x00, 0xe0, 0xfd, 0x36, 0x61, 0x57, 0x09, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -194,6 +422,39 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PeakSupply.Size()
+		i -= size
+		if _, err := m.PeakSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ScheduleStartYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ScheduleStartYear))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PrevBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PrevBlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.AnnualProvisions.Size()
 		i -= size
@@ -207,6 +468,57 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduleUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReductionFactor.Size()
+		i -= size
+		if _, err := m.ReductionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InitialAnnualProvisions.Size()
+		i -= size
+		if _, err := m.InitialAnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -227,6 +539,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintDestinations) > 0 {
+		for iNdEx := len(m.MintDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxBlockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxBlockDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMint(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	if m.ProvisioningMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ProvisioningMode))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -252,12 +601,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n1
-	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i -= n4
+	i = encodeVarintMint(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x12
 	if len(m.MintDenom) > 0 {
@@ -270,6 +619,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedMintDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedMintDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedMintDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -289,6 +685,30 @@ func (m *Minter) Size() (n int) {
 	_ = l
 	l = m.AnnualProvisions.Size()
 	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PrevBlockTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.ScheduleStartYear != 0 {
+		n += 1 + sovMint(uint64(m.ScheduleStartYear))
+	}
+	l = m.PeakSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ScheduleUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InitialAnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.ReductionFactor.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -311,6 +731,38 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if m.ProvisioningMode != 0 {
+		n += 1 + sovMint(uint64(m.ProvisioningMode))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxBlockDuration)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.MintDestinations) > 0 {
+		for _, e := range m.MintDestinations {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedMintDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -383,11 +835,282 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMint(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PrevBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleStartYear", wireType)
+			}
+			m.ScheduleStartYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleStartYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeakSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReductionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMint
@@ -585,6 +1308,274 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningMode", wireType)
+			}
+			m.ProvisioningMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvisioningMode |= ProvisioningMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxBlockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDestinations = append(m.MintDestinations, WeightedMintDestination{})
+			if err := m.MintDestinations[len(m.MintDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedMintDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedMintDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedMintDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
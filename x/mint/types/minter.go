@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,6 +12,8 @@ import (
 func NewMinter(annualProvisions sdk.Dec) Minter {
 	return Minter{
 		AnnualProvisions: annualProvisions,
+		TotalMinted:      sdk.ZeroInt(),
+		PeakSupply:       sdk.ZeroInt(),
 	}
 }
 
@@ -27,6 +31,13 @@ func DefaultInitialMinter() Minter {
 
 // validate minter
 func ValidateMinter(minter Minter) error {
+	if minter.TotalMinted.IsNil() || minter.TotalMinted.IsNegative() {
+		return fmt.Errorf("mint parameter total minted should be non-negative, is %s", minter.TotalMinted)
+	}
+	// the peak supply is unset in genesis files from before it was tracked
+	if !minter.PeakSupply.IsNil() && minter.PeakSupply.IsNegative() {
+		return fmt.Errorf("mint parameter peak supply should be non-negative, is %s", minter.PeakSupply)
+	}
 	return nil
 }
 
@@ -36,8 +47,35 @@ func (m Minter) NextAnnualProvisions(blockTime time.Time, params Params) sdk.Dec
 		return sdk.ZeroDec()
 	}
 
+	return m.yearlyProvisions(currentYear(blockTime, params.StartTime), params)
+}
+
+// yearlyProvisions returns the annual provisions of a year since the start time.
+// The reduction factor is applied once per year since the schedule start year.
+func (m Minter) yearlyProvisions(year uint64, params Params) sdk.Dec {
+	if year < m.ScheduleStartYear {
+		year = m.ScheduleStartYear
+	}
+
 	return params.InitialAnnualProvisions.
-		Mul(params.ReductionFactor.Power(currentYear(blockTime, params.StartTime)))
+		Mul(params.ReductionFactor.Power(year - m.ScheduleStartYear))
+}
+
+// ScheduleYear returns the year since the start time the block time is in,
+// or the first year if the start time isn't reached yet.
+func ScheduleYear(blockTime time.Time, startTime time.Time) uint64 {
+	if !blockTime.After(startTime) {
+		return 0
+	}
+	return currentYear(blockTime, startTime)
+}
+
+// Provision returns the provisions for a block according to the provisioning mode.
+func (m Minter) Provision(blockTime time.Time, params Params) sdk.Coin {
+	if params.ProvisioningMode == ProvisioningModeBlockTime {
+		return m.TimeProvision(blockTime, params)
+	}
+	return m.BlockProvision(params)
 }
 
 // BlockProvision returns the provisions for a block based on the annual
@@ -47,9 +85,57 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
 
+// TimeProvision returns the provisions for the time elapsed since the previous
+// block based on the annual provisions rate. The elapsed time is capped at the
+// max block duration so a halted chain doesn't mint its downtime in one block.
+func (m Minter) TimeProvision(blockTime time.Time, params Params) sdk.Coin {
+	if m.PrevBlockTime.IsZero() || !blockTime.After(m.PrevBlockTime) {
+		return sdk.NewCoin(params.MintDenom, sdk.ZeroInt())
+	}
+	elapsed := blockTime.Sub(m.PrevBlockTime)
+	if elapsed > params.MaxBlockDuration {
+		elapsed = params.MaxBlockDuration
+	}
+	provisionAmt := m.AnnualProvisions.MulInt64(int64(elapsed)).QuoInt64(int64(yearDuration))
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
+
+// Schedule returns the projected annual provisions for the year of the block
+// time and each following year until the provisions round to zero, capped at
+// maxYears entries. A pending schedule update replaces the schedule from the
+// year it takes effect in.
+func (m Minter) Schedule(blockTime time.Time, params Params, pending *ScheduleUpdate, maxYears int) []YearlyProvisions {
+	updated, updatedParams := m, params
+	updateYear := uint64(math.MaxUint64)
+	if pending != nil {
+		updated, updatedParams = pending.Apply(pending.EffectiveTime, m, params)
+		updateYear = updated.ScheduleStartYear
+	}
+
+	schedule := []YearlyProvisions{}
+	for year := ScheduleYear(blockTime, params.StartTime); len(schedule) < maxYears; year++ {
+		provisions := m.yearlyProvisions(year, params)
+		if year >= updateYear {
+			provisions = updated.yearlyProvisions(year, updatedParams)
+		}
+		// years without provisions before the update takes effect are kept
+		if provisions.TruncateInt().IsZero() && (pending == nil || year >= updateYear) {
+			break
+		}
+		schedule = append(schedule, YearlyProvisions{
+			Year:             year,
+			StartTime:        params.StartTime.Add(time.Duration(year) * yearDuration),
+			AnnualProvisions: provisions,
+		})
+	}
+	return schedule
+}
+
+const yearDuration = 365 * 24 * time.Hour
+
 func currentYear(blockTime time.Time, startTime time.Time) uint64 {
 	delta := blockTime.Sub(startTime)
-	year := sdk.NewInt(int64(delta)).QuoRaw(int64(365 * 24 * time.Hour))
+	year := sdk.NewInt(int64(delta)).QuoRaw(int64(yearDuration))
 
 	return year.Uint64()
 }
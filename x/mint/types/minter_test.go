@@ -62,12 +62,155 @@ func TestBlockProvision(t *testing.T) {
 	}
 }
 
+func TestTimeProvision(t *testing.T) {
+	params := DefaultParams()
+	params.ProvisioningMode = ProvisioningModeBlockTime
+	params.MaxBlockDuration = time.Minute
+
+	prevBlockTime := time.Now().UTC()
+	minter := NewMinter(sdk.NewDec(int64(365 * 24 * 60 * 60)))
+	minter.PrevBlockTime = prevBlockTime
+
+	tests := []struct {
+		name          string
+		blockTime     time.Time
+		expProvisions int64
+	}{
+		{"5 second block", prevBlockTime.Add(5 * time.Second), 5},
+		{"7 second block", prevBlockTime.Add(7 * time.Second), 7},
+		{"sub second block", prevBlockTime.Add(500 * time.Millisecond), 0},
+		{"max block duration", prevBlockTime.Add(time.Minute), 60},
+		{"halt is capped", prevBlockTime.Add(24 * time.Hour), 60},
+		{"same block time", prevBlockTime, 0},
+		{"block time before previous block", prevBlockTime.Add(-time.Second), 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			provisions := minter.Provision(tc.blockTime, params)
+			require.Equal(t, params.MintDenom, provisions.Denom)
+			require.Equal(t, tc.expProvisions, provisions.Amount.Int64())
+		})
+	}
+
+	// no previous block time on the first block
+	minter.PrevBlockTime = time.Time{}
+	require.True(t, minter.Provision(prevBlockTime, params).IsZero())
+}
+
+func TestTimeProvisionVariableBlockTimes(t *testing.T) {
+	params := DefaultParams()
+	params.ProvisioningMode = ProvisioningModeBlockTime
+	params.MaxBlockDuration = time.Minute
+
+	annualProvisions := sdk.NewDec(1_000_000_000_000)
+	start := time.Now().UTC()
+	minter := NewMinter(annualProvisions)
+	minter.PrevBlockTime = start
+
+	// a day of blocks with random block times between 1 and 10 seconds and an hour long halt
+	r := rand.New(rand.NewSource(1))
+	blockTime := start
+	halt := time.Duration(0)
+	minted := sdk.ZeroInt()
+	blocks := int64(0)
+	for blockTime.Before(start.Add(24 * time.Hour)) {
+		blockTime = blockTime.Add(time.Duration(1+r.Int63n(10)) * time.Second)
+		if halt == 0 && blockTime.After(start.Add(12*time.Hour)) {
+			blockTime = blockTime.Add(time.Hour)
+			halt = blockTime.Sub(minter.PrevBlockTime)
+		}
+		minted = minted.Add(minter.Provision(blockTime, params).Amount)
+		minter.PrevBlockTime = blockTime
+		blocks++
+	}
+
+	// the whole day is minted for but the part of the halt above the max block
+	// duration, minus less than one token truncated per block
+	elapsed := blockTime.Sub(start) - halt + params.MaxBlockDuration
+	expected := annualProvisions.MulInt64(int64(elapsed)).QuoInt64(int64(365 * 24 * time.Hour))
+	require.True(t, minted.LTE(expected.TruncateInt()), "minted %s expected %s", minted, expected)
+	require.True(t, minted.GT(expected.TruncateInt().SubRaw(blocks)), "minted %s expected %s", minted, expected)
+}
+
+func TestProvisioningModeBlocksPerYear(t *testing.T) {
+	params := DefaultParams()
+	minter := NewMinter(sdk.NewDec(int64(params.BlocksPerYear) * 10))
+	minter.PrevBlockTime = time.Now().UTC()
+
+	// the block time is ignored
+	require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 10), minter.Provision(minter.PrevBlockTime.Add(time.Hour), params))
+}
+
 // Benchmarking :)
 // previously using sdk.Int operations:
 // BenchmarkBlockProvision-4 5000000 220 ns/op
 //
 // using sdk.Dec operations: (current implementation)
 // BenchmarkBlockProvision-4 3000000 429 ns/op
+func TestSchedule(t *testing.T) {
+	minter := InitialMinter()
+	params := DefaultParams()
+	params.InitialAnnualProvisions = sdk.NewDec(1000)
+	params.ReductionFactor = sdk.NewDecWithPrec(5, 1)
+
+	// 1000, 500, ..., 1.953125 before the provisions round to zero
+	schedule := minter.Schedule(params.StartTime.Add(-time.Hour), params, nil, MaxScheduleYears)
+	require.Len(t, schedule, 10)
+	for i, yearly := range schedule {
+		require.Equal(t, uint64(i), yearly.Year)
+		require.Equal(t, params.StartTime.Add(time.Duration(i)*yearDuration), yearly.StartTime)
+	}
+	require.Equal(t, sdk.NewDec(1000), schedule[0].AnnualProvisions)
+	require.Equal(t, sdk.MustNewDecFromStr("1.953125"), schedule[9].AnnualProvisions)
+
+	// starts with the current year
+	schedule = minter.Schedule(params.StartTime.Add(3*yearDuration+time.Hour), params, nil, MaxScheduleYears)
+	require.Len(t, schedule, 7)
+	require.Equal(t, uint64(3), schedule[0].Year)
+	require.Equal(t, sdk.NewDec(125), schedule[0].AnnualProvisions)
+
+	// reduced since the schedule start year
+	minter.ScheduleStartYear = 2
+	schedule = minter.Schedule(params.StartTime.Add(3*yearDuration+time.Hour), params, nil, MaxScheduleYears)
+	require.Len(t, schedule, 9)
+	require.Equal(t, uint64(3), schedule[0].Year)
+	require.Equal(t, sdk.NewDec(500), schedule[0].AnnualProvisions)
+	require.Equal(t, minter.NextAnnualProvisions(schedule[0].StartTime.Add(time.Hour), params), schedule[0].AnnualProvisions)
+
+	// a pending update replaces the schedule from the year it takes effect in
+	pending := &ScheduleUpdate{
+		EffectiveTime:           params.StartTime.Add(5*yearDuration + time.Hour),
+		InitialAnnualProvisions: sdk.NewDec(600),
+		ReductionFactor:         sdk.NewDecWithPrec(9, 1),
+	}
+	schedule = minter.Schedule(params.StartTime.Add(3*yearDuration+time.Hour), params, pending, MaxScheduleYears)
+	require.Len(t, schedule, 63)
+	require.Equal(t, sdk.NewDec(500), schedule[0].AnnualProvisions)
+	require.Equal(t, sdk.NewDec(250), schedule[1].AnnualProvisions)
+	require.Equal(t, uint64(5), schedule[2].Year)
+	require.Equal(t, sdk.NewDec(600), schedule[2].AnnualProvisions)
+	require.Equal(t, sdk.NewDec(540), schedule[3].AnnualProvisions)
+	updated, updatedParams := pending.Apply(pending.EffectiveTime, minter, params)
+	require.Equal(t, updated.NextAnnualProvisions(schedule[3].StartTime.Add(time.Hour), updatedParams), schedule[3].AnnualProvisions)
+
+	// years without provisions are kept until the update takes effect
+	zeroParams := params
+	zeroParams.InitialAnnualProvisions = sdk.ZeroDec()
+	schedule = minter.Schedule(params.StartTime.Add(3*yearDuration+time.Hour), zeroParams, pending, MaxScheduleYears)
+	require.Equal(t, uint64(3), schedule[0].Year)
+	require.True(t, schedule[0].AnnualProvisions.IsZero())
+	require.Equal(t, sdk.NewDec(600), schedule[2].AnnualProvisions)
+
+	// capped when the provisions never round to zero
+	params.ReductionFactor = sdk.OneDec()
+	schedule = minter.Schedule(params.StartTime, params, nil, MaxScheduleYears)
+	require.Len(t, schedule, MaxScheduleYears)
+
+	// nothing left to mint
+	params.InitialAnnualProvisions = sdk.ZeroDec()
+	require.Empty(t, minter.Schedule(params.StartTime, params, nil, MaxScheduleYears))
+}
+
 func BenchmarkBlockProvision(b *testing.B) {
 	minter := InitialMinter()
 	params := DefaultParams()
@@ -19,6 +19,10 @@ var (
 	KeyInitialAnnualProvisions = []byte("InitialAnnualProvisions")
 	KeyReductionFactor         = []byte("ReductionFactor")
 	KeyBlocksPerYear           = []byte("BlocksPerYear")
+	KeyProvisioningMode        = []byte("ProvisioningMode")
+	KeyMaxBlockDuration        = []byte("MaxBlockDuration")
+	KeyMaxSupply               = []byte("MaxSupply")
+	KeyMintDestinations        = []byte("MintDestinations")
 )
 
 // ParamTable for minting module.
@@ -28,6 +32,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(
 	mintDenom string, startTime time.Time, initialAnnualProvisions, reductionFactor sdk.Dec, blocksPerYear uint64,
+	provisioningMode ProvisioningMode, maxBlockDuration time.Duration, maxSupply sdk.Int,
+	mintDestinations []WeightedMintDestination,
 ) Params {
 
 	return Params{
@@ -36,6 +42,10 @@ func NewParams(
 		InitialAnnualProvisions: initialAnnualProvisions,
 		ReductionFactor:         reductionFactor,
 		BlocksPerYear:           blocksPerYear,
+		ProvisioningMode:        provisioningMode,
+		MaxBlockDuration:        maxBlockDuration,
+		MaxSupply:               maxSupply,
+		MintDestinations:        mintDestinations,
 	}
 }
 
@@ -48,6 +58,10 @@ func DefaultParams() Params {
 		ReductionFactor:         sdk.NewDec(2).QuoInt64(3),         // 2/3
 		BlocksPerYear:           uint64(6311520),                   // 60 * 60 * 8766 / 5 = 6,311,520
 		//  assuming 5 second block times
+		ProvisioningMode: ProvisioningModeBlocksPerYear,
+		MaxBlockDuration: time.Minute,
+		MaxSupply:        sdk.ZeroInt(),               // no max supply
+		MintDestinations: []WeightedMintDestination{}, // everything to the fee collector
 	}
 }
 
@@ -65,10 +79,27 @@ func (p Params) Validate() error {
 	if err := validateReductionFactor(p.ReductionFactor); err != nil {
 		return err
 	}
-	err := validateBlocksPerYear(p.BlocksPerYear)
+	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
+		return err
+	}
+	if err := validateProvisioningMode(p.ProvisioningMode); err != nil {
+		return err
+	}
+	if err := validateMaxBlockDuration(p.MaxBlockDuration); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	err := validateMintDestinations(p.MintDestinations)
 	return err
 }
 
+// HasMaxSupply returns true if minting is capped by a max supply
+func (p Params) HasMaxSupply() bool {
+	return p.MaxSupply.IsPositive()
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -83,6 +114,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInitialAnnualProvisions, &p.InitialAnnualProvisions, validateStartProvisions),
 		paramtypes.NewParamSetPair(KeyReductionFactor, &p.ReductionFactor, validateReductionFactor),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyProvisioningMode, &p.ProvisioningMode, validateProvisioningMode),
+		paramtypes.NewParamSetPair(KeyMaxBlockDuration, &p.MaxBlockDuration, validateMaxBlockDuration),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeyMintDestinations, &p.MintDestinations, validateMintDestinations),
 	}
 }
 
@@ -154,3 +189,94 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateProvisioningMode(i interface{}) error {
+	v, ok := i.(ProvisioningMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ProvisioningMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid provisioning mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxBlockDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max block duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max supply cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMintDestinations(i interface{}) error {
+	v, ok := i.([]WeightedMintDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// no destinations sends everything to the fee collector
+	if len(v) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	total := sdk.ZeroDec()
+	for _, d := range v {
+		switch {
+		case d.Module != "" && d.Address != "":
+			return fmt.Errorf("mint destination cannot have both a module (%s) and an address (%s)", d.Module, d.Address)
+		case d.Module != "":
+			if strings.TrimSpace(d.Module) == "" {
+				return errors.New("mint destination module cannot be blank")
+			}
+		case d.Address != "":
+			if _, err := sdk.AccAddressFromBech32(d.Address); err != nil {
+				return fmt.Errorf("invalid mint destination address %s: %w", d.Address, err)
+			}
+		default:
+			return errors.New("mint destination must have a module or an address")
+		}
+
+		key := d.Module + "/" + d.Address
+		if seen[key] {
+			return fmt.Errorf("duplicate mint destination %s%s", d.Module, d.Address)
+		}
+		seen[key] = true
+
+		if d.Weight.IsNil() || !d.Weight.IsPositive() {
+			return fmt.Errorf("mint destination weight must be positive: %s", d.Weight)
+		}
+		total = total.Add(d.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("mint destination weights must sum to 1: %s", total)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/public-awesome/stargaze/testutil/sample"
+)
+
+func TestValidateMintDestinations(t *testing.T) {
+	address := sample.AccAddress()
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		name         string
+		destinations []WeightedMintDestination
+		valid        bool
+	}{
+		{
+			name:         "empty",
+			destinations: []WeightedMintDestination{},
+			valid:        true,
+		}, {
+			name: "module and address",
+			destinations: []WeightedMintDestination{
+				{Module: "fee_collector", Address: address, Weight: sdk.OneDec()},
+			},
+		}, {
+			name: "no module or address",
+			destinations: []WeightedMintDestination{
+				{Weight: sdk.OneDec()},
+			},
+		}, {
+			name: "blank module",
+			destinations: []WeightedMintDestination{
+				{Module: " ", Weight: sdk.OneDec()},
+			},
+		}, {
+			name: "invalid address",
+			destinations: []WeightedMintDestination{
+				{Address: "invalid_address", Weight: sdk.OneDec()},
+			},
+		}, {
+			name: "duplicate destination",
+			destinations: []WeightedMintDestination{
+				{Address: address, Weight: half},
+				{Address: address, Weight: half},
+			},
+		}, {
+			name: "zero weight",
+			destinations: []WeightedMintDestination{
+				{Module: "fee_collector", Weight: sdk.OneDec()},
+				{Address: address, Weight: sdk.ZeroDec()},
+			},
+		}, {
+			name: "weights not summing to 1",
+			destinations: []WeightedMintDestination{
+				{Module: "fee_collector", Weight: half},
+				{Address: address, Weight: sdk.NewDecWithPrec(4, 1)},
+			},
+		}, {
+			name: "valid",
+			destinations: []WeightedMintDestination{
+				{Module: "fee_collector", Weight: half},
+				{Address: address, Weight: half},
+			},
+			valid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMintDestinations(tt.destinations)
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeScheduleUpdate defines the type for a ScheduleUpdateProposal
+	ProposalTypeScheduleUpdate = "ScheduleUpdate"
+)
+
+// Assert ScheduleUpdateProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &ScheduleUpdateProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeScheduleUpdate)
+	govtypes.RegisterProposalTypeCodec(&ScheduleUpdateProposal{}, "mint/ScheduleUpdateProposal")
+}
+
+// NewScheduleUpdateProposal creates a new minting schedule update proposal.
+func NewScheduleUpdateProposal(title, description string, effectiveTime time.Time, initialAnnualProvisions, reductionFactor sdk.Dec) *ScheduleUpdateProposal {
+	return &ScheduleUpdateProposal{title, description, effectiveTime, initialAnnualProvisions, reductionFactor}
+}
+
+// GetTitle returns the title of a minting schedule update proposal.
+func (p *ScheduleUpdateProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a minting schedule update proposal.
+func (p *ScheduleUpdateProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a minting schedule update proposal.
+func (p *ScheduleUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a minting schedule update proposal.
+func (p *ScheduleUpdateProposal) ProposalType() string { return ProposalTypeScheduleUpdate }
+
+// ScheduleUpdate returns the schedule update of the proposal.
+func (p *ScheduleUpdateProposal) ScheduleUpdate() ScheduleUpdate {
+	return ScheduleUpdate{
+		EffectiveTime:           p.EffectiveTime,
+		InitialAnnualProvisions: p.InitialAnnualProvisions,
+		ReductionFactor:         p.ReductionFactor,
+	}
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ScheduleUpdateProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if err := p.ScheduleUpdate().Validate(); err != nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ScheduleUpdateProposal) String() string {
+	return fmt.Sprintf(`Mint Schedule Update Proposal:
+  Title:                     %s
+  Description:               %s
+  Effective Time:            %s
+  Initial Annual Provisions: %s
+  Reduction Factor:          %s
+`, p.Title, p.Description, p.EffectiveTime, p.InitialAnnualProvisions, p.ReductionFactor)
+}
+
+// Apply returns the minter and params once the update takes effect at the block time. The start
+// time is kept so the year count continues, the new schedule starts in the year of the block time.
+func (u ScheduleUpdate) Apply(blockTime time.Time, minter Minter, params Params) (Minter, Params) {
+	params.InitialAnnualProvisions = u.InitialAnnualProvisions
+	params.ReductionFactor = u.ReductionFactor
+	minter.ScheduleStartYear = ScheduleYear(blockTime, params.StartTime)
+	return minter, params
+}
+
+// Validate validates the schedule update
+func (u ScheduleUpdate) Validate() error {
+	if u.EffectiveTime.IsZero() {
+		return fmt.Errorf("effective time cannot be zero value")
+	}
+	if u.InitialAnnualProvisions.IsNil() {
+		return fmt.Errorf("initial annual provisions cannot be nil")
+	}
+	if err := validateStartProvisions(u.InitialAnnualProvisions); err != nil {
+		return err
+	}
+	if u.ReductionFactor.IsNil() {
+		return fmt.Errorf("reduction factor cannot be nil")
+	}
+	return validateReductionFactor(u.ReductionFactor)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleUpdateProposal_ValidateBasic(t *testing.T) {
+	effectiveTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	provisions := sdk.NewDec(1000)
+	reductionFactor := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		name     string
+		proposal *ScheduleUpdateProposal
+		err      error
+	}{
+		{
+			name:     "empty title",
+			proposal: NewScheduleUpdateProposal("", "description", effectiveTime, provisions, reductionFactor),
+			err:      govtypes.ErrInvalidProposalContent,
+		}, {
+			name:     "zero effective time",
+			proposal: NewScheduleUpdateProposal("title", "description", time.Time{}, provisions, reductionFactor),
+			err:      govtypes.ErrInvalidProposalContent,
+		}, {
+			name:     "nil initial annual provisions",
+			proposal: NewScheduleUpdateProposal("title", "description", effectiveTime, sdk.Dec{}, reductionFactor),
+			err:      govtypes.ErrInvalidProposalContent,
+		}, {
+			name:     "negative initial annual provisions",
+			proposal: NewScheduleUpdateProposal("title", "description", effectiveTime, sdk.NewDec(-1), reductionFactor),
+			err:      govtypes.ErrInvalidProposalContent,
+		}, {
+			name:     "reduction factor greater than 1",
+			proposal: NewScheduleUpdateProposal("title", "description", effectiveTime, provisions, sdk.NewDec(2)),
+			err:      govtypes.ErrInvalidProposalContent,
+		}, {
+			name:     "negative reduction factor",
+			proposal: NewScheduleUpdateProposal("title", "description", effectiveTime, provisions, sdk.NewDec(-1)),
+			err:      govtypes.ErrInvalidProposalContent,
+		}, {
+			name:     "valid",
+			proposal: NewScheduleUpdateProposal("title", "description", effectiveTime, provisions, reductionFactor),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}